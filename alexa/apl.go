@@ -0,0 +1,297 @@
+package alexa
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+)
+
+// APLRenderDocumentDirective instructs the device to display the APL content provided in the specified document.
+// Datasources can be bound to the document by the name used in the documents mainTemplate parameters.
+type APLRenderDocumentDirective struct {
+	Type string `json:"type"`
+	// Token is used to identify the document in later ExecuteCommands directives and UserEvent requests.
+	Token       string                 `json:"token"`
+	Document    interface{}            `json:"document"`
+	Datasources map[string]interface{} `json:"datasources,omitempty"`
+}
+
+// APLExecuteCommandsDirective sends commands to a APL document which was rendered with the same token before.
+type APLExecuteCommandsDirective struct {
+	Type     string        `json:"type"`
+	Token    string        `json:"token"`
+	Commands []interface{} `json:"commands"`
+}
+
+// APLCommonCommand contains the attributes all APL commands have in common.
+type APLCommonCommand struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// DelayInMilliseconds delays the execution of the command.
+	DelayInMilliseconds int `json:"delay,omitempty"`
+	// When is a data-binding expression. The command is skipped if it evaluates to false.
+	When string `json:"when,omitempty"`
+}
+
+// APLSpeakItemCommand reads the contents of a single item on the screen. The item is scrolled into view and may be highlighted.
+type APLSpeakItemCommand struct {
+	APLCommonCommand
+	ComponentID string `json:"componentId"`
+	// Align may be one of 'first', 'center', 'last' or 'visible'
+	Align string `json:"align,omitempty"`
+	// HighlightMode may be one of 'block' or 'line'
+	HighlightMode                  string `json:"highlightMode,omitempty"`
+	MinimumDwellTimeInMilliseconds int    `json:"minimumDwellTime,omitempty"`
+}
+
+// APLSpeakListCommand reads the contents of a range of items inside a common container.
+type APLSpeakListCommand struct {
+	APLCommonCommand
+	ComponentID                    string `json:"componentId"`
+	Start                          int    `json:"start"`
+	Count                          int    `json:"count"`
+	Align                          string `json:"align,omitempty"`
+	MinimumDwellTimeInMilliseconds int    `json:"minimumDwellTime,omitempty"`
+}
+
+// APLScrollCommand scrolls a ScrollView or Sequence forward or backward by a set number of pages.
+type APLScrollCommand struct {
+	APLCommonCommand
+	ComponentID string `json:"componentId"`
+	// Distance is the number of pages to scroll. Negative values scroll backward.
+	Distance float64 `json:"distance"`
+}
+
+// APLScrollToIndexCommand scrolls forward or backward through a ScrollView or Sequence to ensure that a particular child component is in view.
+type APLScrollToIndexCommand struct {
+	APLCommonCommand
+	ComponentID string `json:"componentId"`
+	Index       int    `json:"index"`
+	Align       string `json:"align,omitempty"`
+}
+
+// APLSetPageCommand changes the page displayed in a Pager component.
+type APLSetPageCommand struct {
+	APLCommonCommand
+	ComponentID string `json:"componentId"`
+	// Position may be 'absolute' or 'relative'
+	Position string `json:"position,omitempty"`
+	Value    int    `json:"value"`
+}
+
+// APLAutoPageCommand automatically progresses through a series of pages displayed in a Pager component.
+type APLAutoPageCommand struct {
+	APLCommonCommand
+	ComponentID            string `json:"componentId"`
+	Count                  int    `json:"count,omitempty"`
+	DurationInMilliseconds int    `json:"duration,omitempty"`
+}
+
+// APLSetValueCommand changes a property or binding of a component.
+type APLSetValueCommand struct {
+	APLCommonCommand
+	ComponentID string      `json:"componentId,omitempty"`
+	Property    string      `json:"property"`
+	Value       interface{} `json:"value"`
+}
+
+// APLSendEventCommand sends a Alexa.Presentation.APL.UserEvent request to the skill.
+type APLSendEventCommand struct {
+	APLCommonCommand
+	Arguments  []interface{} `json:"arguments,omitempty"`
+	Components []string      `json:"components,omitempty"`
+}
+
+// APLIdleCommand does nothing. It may be used as a placeholder or to insert a delay in a series of commands.
+type APLIdleCommand struct {
+	APLCommonCommand
+}
+
+// APLSequentialCommand executes a series of commands in order.
+type APLSequentialCommand struct {
+	APLCommonCommand
+	Commands []interface{} `json:"commands"`
+	Repeat   int           `json:"repeatCount,omitempty"`
+}
+
+// APLParallelCommand executes a series of commands in parallel.
+type APLParallelCommand struct {
+	APLCommonCommand
+	Commands []interface{} `json:"commands"`
+}
+
+// APLUserEventRequest is send if a SendEvent command was executed on the device, e.g. when a user touches a button.
+type APLUserEventRequest struct {
+	CommonRequest
+	// Token of the document which sent the event.
+	Token     string        `json:"token"`
+	Arguments []interface{} `json:"arguments"`
+	// Source contains information about the component which triggered the event.
+	Source map[string]interface{} `json:"source"`
+	// Components contains the values of the components referenced by the SendEvent command.
+	Components map[string]interface{} `json:"components"`
+}
+
+var errInvalidAPLDocument = errors.New("Invalid APL document: type must be APL")
+
+// LoadAPLDocument reads the APL document with the given file name from fsys. Usually fsys is a embed.FS containing the documents exported from the authoring tool.
+// The returned document can be used as document of a APLRenderDocumentDirective.
+func LoadAPLDocument(fsys fs.FS, name string) (json.RawMessage, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	var document struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if document.Type != "APL" {
+		return nil, errInvalidAPLDocument
+	}
+	return json.RawMessage(data), nil
+}
+
+// AddAPLRenderDocumentDirective creates a new directive to render the given APL document and adds it to the response.
+func (r *Response) AddAPLRenderDocumentDirective(token string, document interface{}) *APLRenderDocumentDirective {
+	d := &APLRenderDocumentDirective{
+		Type:     "Alexa.Presentation.APL.RenderDocument",
+		Token:    token,
+		Document: document,
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// AddDatasource adds a datasource with the given name to the directive. A present datasource with the same name is overwritten.
+func (d *APLRenderDocumentDirective) AddDatasource(name string, datasource interface{}) {
+	if d.Datasources == nil {
+		d.Datasources = make(map[string]interface{})
+	}
+	d.Datasources[name] = datasource
+}
+
+// AddAPLExecuteCommandsDirective creates a new directive to execute commands on the document with the given token and adds it to the response.
+func (r *Response) AddAPLExecuteCommandsDirective(token string, commands ...interface{}) *APLExecuteCommandsDirective {
+	if commands == nil {
+		commands = make([]interface{}, 0)
+	}
+	d := &APLExecuteCommandsDirective{
+		Type:     "Alexa.Presentation.APL.ExecuteCommands",
+		Token:    token,
+		Commands: commands,
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// AddCommand adds a command to the directive.
+func (d *APLExecuteCommandsDirective) AddCommand(command interface{}) {
+	d.Commands = append(d.Commands, command)
+}
+
+// NewAPLSpeakItemCommand creates a command to read the content of the component with the given id.
+func NewAPLSpeakItemCommand(componentID string) *APLSpeakItemCommand {
+	return &APLSpeakItemCommand{
+		APLCommonCommand: APLCommonCommand{Type: "SpeakItem"},
+		ComponentID:      componentID,
+	}
+}
+
+// NewAPLSpeakListCommand creates a command to read count items of the component with the given id starting at start.
+func NewAPLSpeakListCommand(componentID string, start, count int) *APLSpeakListCommand {
+	return &APLSpeakListCommand{
+		APLCommonCommand: APLCommonCommand{Type: "SpeakList"},
+		ComponentID:      componentID,
+		Start:            start,
+		Count:            count,
+	}
+}
+
+// NewAPLScrollCommand creates a command to scroll the component with the given id by distance pages.
+func NewAPLScrollCommand(componentID string, distance float64) *APLScrollCommand {
+	return &APLScrollCommand{
+		APLCommonCommand: APLCommonCommand{Type: "Scroll"},
+		ComponentID:      componentID,
+		Distance:         distance,
+	}
+}
+
+// NewAPLScrollToIndexCommand creates a command to scroll the child with the given index into view.
+func NewAPLScrollToIndexCommand(componentID string, index int) *APLScrollToIndexCommand {
+	return &APLScrollToIndexCommand{
+		APLCommonCommand: APLCommonCommand{Type: "ScrollToIndex"},
+		ComponentID:      componentID,
+		Index:            index,
+	}
+}
+
+// NewAPLSetPageCommand creates a command to change the page of the pager with the given id.
+func NewAPLSetPageCommand(componentID, position string, value int) *APLSetPageCommand {
+	return &APLSetPageCommand{
+		APLCommonCommand: APLCommonCommand{Type: "SetPage"},
+		ComponentID:      componentID,
+		Position:         position,
+		Value:            value,
+	}
+}
+
+// NewAPLAutoPageCommand creates a command to progress through count pages of the pager with the given id.
+func NewAPLAutoPageCommand(componentID string, count, durationInMilliseconds int) *APLAutoPageCommand {
+	return &APLAutoPageCommand{
+		APLCommonCommand:       APLCommonCommand{Type: "AutoPage"},
+		ComponentID:            componentID,
+		Count:                  count,
+		DurationInMilliseconds: durationInMilliseconds,
+	}
+}
+
+// NewAPLSetValueCommand creates a command to change the property of the component with the given id.
+func NewAPLSetValueCommand(componentID, property string, value interface{}) *APLSetValueCommand {
+	return &APLSetValueCommand{
+		APLCommonCommand: APLCommonCommand{Type: "SetValue"},
+		ComponentID:      componentID,
+		Property:         property,
+		Value:            value,
+	}
+}
+
+// NewAPLSendEventCommand creates a command to send a UserEvent request with the given arguments to the skill.
+func NewAPLSendEventCommand(arguments ...interface{}) *APLSendEventCommand {
+	return &APLSendEventCommand{
+		APLCommonCommand: APLCommonCommand{Type: "SendEvent"},
+		Arguments:        arguments,
+	}
+}
+
+// NewAPLIdleCommand creates a command which waits for the given delay.
+func NewAPLIdleCommand(delayInMilliseconds int) *APLIdleCommand {
+	return &APLIdleCommand{
+		APLCommonCommand: APLCommonCommand{Type: "Idle", DelayInMilliseconds: delayInMilliseconds},
+	}
+}
+
+// NewAPLSequentialCommand creates a command to execute the given commands in order.
+func NewAPLSequentialCommand(commands ...interface{}) *APLSequentialCommand {
+	if len(commands) == 0 {
+		// Alexa rejects "commands": null
+		commands = make([]interface{}, 0)
+	}
+	return &APLSequentialCommand{
+		APLCommonCommand: APLCommonCommand{Type: "Sequential"},
+		Commands:         commands,
+	}
+}
+
+// NewAPLParallelCommand creates a command to execute the given commands in parallel.
+func NewAPLParallelCommand(commands ...interface{}) *APLParallelCommand {
+	if len(commands) == 0 {
+		// Alexa rejects "commands": null
+		commands = make([]interface{}, 0)
+	}
+	return &APLParallelCommand{
+		APLCommonCommand: APLCommonCommand{Type: "Parallel"},
+		Commands:         commands,
+	}
+}
@@ -0,0 +1,116 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPLUserEvent(t *testing.T) {
+	skill := Skill{
+		ApplicationID:  "amzn1.echo-sdk-ams.app.000000-d0ed-0000-ad00-000000d00ebe",
+		SkipValidation: true,
+	}
+	skillHandler := skill.GetHTTPSkillHandler()
+
+	called := false
+	skill.OnAPLUserEvent = func(request *APLUserEventRequest, response *ResponseEnvelope) {
+		called = true
+		assert.Equal(t, "Alexa.Presentation.APL.UserEvent", request.Type)
+		assert.Equal(t, "helloToken", request.Token)
+		assert.Equal(t, []interface{}{"buttonPressed", 42.0}, request.Arguments)
+		assert.Equal(t, "helloButton", request.Source["id"])
+		assert.Equal(t, "Alexa", request.Components["nameInput"])
+	}
+
+	requestReader, err := os.Open("../resources/apl_userevent_request.json")
+	if err != nil {
+		t.Error("Error reading input file", err)
+	}
+
+	httpRequest := httptest.NewRequest("POST", "/", requestReader)
+	responseWriter := httptest.NewRecorder()
+	skillHandler.ServeHTTP(responseWriter, httpRequest)
+	if responseWriter.Code != http.StatusOK {
+		t.Errorf("handler returned wrong status code: got %v want %v",
+			responseWriter.Code, http.StatusOK)
+	}
+	assert.True(t, called)
+}
+
+func TestAPLDirectives(t *testing.T) {
+	skill := Skill{
+		ApplicationID:  "amzn1.echo-sdk-ams.app.000000-d0ed-0000-ad00-000000d00ebe",
+		SkipValidation: true,
+	}
+	skillHandler := skill.GetHTTPSkillHandler()
+
+	skill.OnLaunch = func(request *LaunchRequest, responseEnvelope *ResponseEnvelope) {
+		document, err := LoadAPLDocument(os.DirFS("../resources"), "apl_document.json")
+		require.NoError(t, err)
+		d := responseEnvelope.Response.AddAPLRenderDocumentDirective("helloToken", document)
+		d.AddDatasource("helloData", map[string]interface{}{"text": "Hello world"})
+
+		c := responseEnvelope.Response.AddAPLExecuteCommandsDirective("helloToken", NewAPLSpeakItemCommand("helloText"))
+		c.AddCommand(NewAPLSequentialCommand(NewAPLIdleCommand(500), NewAPLSetValueCommand("helloText", "text", "Bye")))
+		c.AddCommand(NewAPLScrollCommand("list", -1))
+	}
+
+	launchRequestReader, err := os.Open("../resources/launch_request.json")
+	if err != nil {
+		t.Error("Error reading input file", err)
+	}
+
+	httpRequest := httptest.NewRequest("POST", "/", launchRequestReader)
+	responseWriter := httptest.NewRecorder()
+	skillHandler.ServeHTTP(responseWriter, httpRequest)
+	if responseWriter.Code != http.StatusOK {
+		t.Errorf("handler returned wrong status code: got %v want %v",
+			responseWriter.Code, http.StatusOK)
+	}
+	respBytes, _ := ioutil.ReadAll(responseWriter.Body)
+	var resp map[string]interface{}
+	json.Unmarshal(respBytes, &resp)
+	directives := resp["response"].(map[string]interface{})["directives"].([]interface{})
+	require.Equal(t, 2, len(directives))
+
+	render := directives[0].(map[string]interface{})
+	assert.Equal(t, "Alexa.Presentation.APL.RenderDocument", render["type"])
+	assert.Equal(t, "helloToken", render["token"])
+	assert.Equal(t, "APL", render["document"].(map[string]interface{})["type"])
+	assert.Equal(t, "Hello world", render["datasources"].(map[string]interface{})["helloData"].(map[string]interface{})["text"])
+
+	execute := directives[1].(map[string]interface{})
+	assert.Equal(t, "Alexa.Presentation.APL.ExecuteCommands", execute["type"])
+	commands := execute["commands"].([]interface{})
+	require.Equal(t, 3, len(commands))
+	assert.Equal(t, "SpeakItem", commands[0].(map[string]interface{})["type"])
+	assert.Equal(t, "helloText", commands[0].(map[string]interface{})["componentId"])
+	sequential := commands[1].(map[string]interface{})
+	assert.Equal(t, "Sequential", sequential["type"])
+	assert.Equal(t, "Idle", sequential["commands"].([]interface{})[0].(map[string]interface{})["type"])
+	assert.Equal(t, 500.0, sequential["commands"].([]interface{})[0].(map[string]interface{})["delay"])
+	assert.Equal(t, "Bye", sequential["commands"].([]interface{})[1].(map[string]interface{})["value"])
+	assert.Equal(t, -1.0, commands[2].(map[string]interface{})["distance"])
+}
+
+func TestEmptyAPLCommands(t *testing.T) {
+	bytes, _ := json.Marshal(NewAPLSequentialCommand())
+	assert.JSONEq(t, `{"type": "Sequential", "commands": []}`, string(bytes))
+	bytes, _ = json.Marshal(NewAPLParallelCommand())
+	assert.JSONEq(t, `{"type": "Parallel", "commands": []}`, string(bytes))
+}
+
+func TestLoadInvalidAPLDocument(t *testing.T) {
+	_, err := LoadAPLDocument(os.DirFS("../resources"), "launch_request.json")
+	assert.Equal(t, errInvalidAPLDocument, err)
+
+	_, err = LoadAPLDocument(os.DirFS("../resources"), "missing.json")
+	assert.Error(t, err)
+}
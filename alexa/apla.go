@@ -0,0 +1,192 @@
+package alexa
+
+// APLARenderDocumentDirective instructs Alexa to render the APL for Audio document and play the resulting audio to the user.
+// When the response contains output speech, the output speech is played before the document.
+type APLARenderDocumentDirective struct {
+	Type        string                 `json:"type"`
+	Token       string                 `json:"token"`
+	Document    interface{}            `json:"document"`
+	Datasources map[string]interface{} `json:"datasources,omitempty"`
+}
+
+// APLADocument is the root of a APL for Audio document.
+type APLADocument struct {
+	// Must be APLA
+	Type         string           `json:"type"`
+	Version      string           `json:"version"`
+	Description  string           `json:"description,omitempty"`
+	MainTemplate APLAMainTemplate `json:"mainTemplate"`
+}
+
+// APLAMainTemplate contains the component which is rendered when the document is inflated.
+type APLAMainTemplate struct {
+	// Parameters are the names of the datasources bound to the template.
+	Parameters []string    `json:"parameters,omitempty"`
+	Item       interface{} `json:"item"`
+}
+
+// APLACommonComponent contains the attributes all APL for Audio components have in common.
+type APLACommonComponent struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	// When is a data-binding expression. The component is not rendered if it evaluates to false.
+	When string `json:"when,omitempty"`
+}
+
+// APLASpeech converts plain text or SSML to speech.
+type APLASpeech struct {
+	APLACommonComponent
+	// ContentType must be PlainText or SSML
+	ContentType string `json:"contentType,omitempty"`
+	Content     string `json:"content"`
+}
+
+// APLAAudio plays the audio file at the given source url.
+type APLAAudio struct {
+	APLACommonComponent
+	Source string `json:"source"`
+	// Filter contains filters like Volume, FadeIn or Trim applied to the audio clip.
+	Filter []APLAFilter `json:"filter,omitempty"`
+}
+
+// APLAFilter modifies the audio output of a Audio component.
+type APLAFilter struct {
+	// Type is one of FadeIn, FadeOut, Trim, Repeat or Volume
+	Type        string      `json:"type"`
+	Amount      interface{} `json:"amount,omitempty"`
+	Duration    int         `json:"duration,omitempty"`
+	Start       int         `json:"start,omitempty"`
+	End         int         `json:"end,omitempty"`
+	RepeatCount int         `json:"repeatCount,omitempty"`
+}
+
+// APLASilence plays silence for the given duration.
+type APLASilence struct {
+	APLACommonComponent
+	DurationInMilliseconds int `json:"duration"`
+}
+
+// APLASequencer plays its child components one after the other.
+type APLASequencer struct {
+	APLACommonComponent
+	Items []interface{} `json:"items"`
+}
+
+// APLAMixer plays its child components at the same time.
+type APLAMixer struct {
+	APLACommonComponent
+	Items []interface{} `json:"items"`
+}
+
+// APLASelector renders a single child component.
+type APLASelector struct {
+	APLACommonComponent
+	// Strategy is one of normal, randomItem, randomData or randomItemRandomData
+	Strategy string        `json:"strategy,omitempty"`
+	Items    []interface{} `json:"items"`
+}
+
+// AddAPLARenderDocumentDirective creates a new directive to render the given APL for Audio document and adds it to the response.
+func (r *Response) AddAPLARenderDocumentDirective(token string, document interface{}) *APLARenderDocumentDirective {
+	d := &APLARenderDocumentDirective{
+		Type:     "Alexa.Presentation.APLA.RenderDocument",
+		Token:    token,
+		Document: document,
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// AddDatasource adds a datasource with the given name to the directive. A present datasource with the same name is overwritten.
+func (d *APLARenderDocumentDirective) AddDatasource(name string, datasource interface{}) {
+	if d.Datasources == nil {
+		d.Datasources = make(map[string]interface{})
+	}
+	d.Datasources[name] = datasource
+}
+
+// NewAPLADocument creates a APL for Audio document which renders the given component.
+func NewAPLADocument(item interface{}, parameters ...string) *APLADocument {
+	return &APLADocument{
+		Type:    "APLA",
+		Version: "0.9",
+		MainTemplate: APLAMainTemplate{
+			Parameters: parameters,
+			Item:       item,
+		},
+	}
+}
+
+// NewAPLASpeech creates a component to speak the given plain text.
+func NewAPLASpeech(text string) *APLASpeech {
+	return &APLASpeech{
+		APLACommonComponent: APLACommonComponent{Type: "Speech"},
+		ContentType:         "PlainText",
+		Content:             text,
+	}
+}
+
+// NewAPLASSMLSpeech creates a component to speak the given SSML. The content must be wrapped in speak tags.
+func NewAPLASSMLSpeech(ssml string) *APLASpeech {
+	return &APLASpeech{
+		APLACommonComponent: APLACommonComponent{Type: "Speech"},
+		ContentType:         "SSML",
+		Content:             ssml,
+	}
+}
+
+// NewAPLAAudio creates a component to play the audio file at the given url.
+func NewAPLAAudio(source string) *APLAAudio {
+	return &APLAAudio{
+		APLACommonComponent: APLACommonComponent{Type: "Audio"},
+		Source:              source,
+	}
+}
+
+// AddFilter adds a filter to the audio component.
+func (a *APLAAudio) AddFilter(filter APLAFilter) *APLAAudio {
+	a.Filter = append(a.Filter, filter)
+	return a
+}
+
+// NewAPLASilence creates a component to play silence for the given duration.
+func NewAPLASilence(durationInMilliseconds int) *APLASilence {
+	return &APLASilence{
+		APLACommonComponent:    APLACommonComponent{Type: "Silence"},
+		DurationInMilliseconds: durationInMilliseconds,
+	}
+}
+
+// NewAPLASequencer creates a component which plays the given components in order.
+func NewAPLASequencer(items ...interface{}) *APLASequencer {
+	if items == nil {
+		items = make([]interface{}, 0)
+	}
+	return &APLASequencer{
+		APLACommonComponent: APLACommonComponent{Type: "Sequencer"},
+		Items:               items,
+	}
+}
+
+// NewAPLAMixer creates a component which plays the given components at the same time.
+func NewAPLAMixer(items ...interface{}) *APLAMixer {
+	if items == nil {
+		items = make([]interface{}, 0)
+	}
+	return &APLAMixer{
+		APLACommonComponent: APLACommonComponent{Type: "Mixer"},
+		Items:               items,
+	}
+}
+
+// NewAPLASelector creates a component which plays one of the given components selected by the strategy.
+func NewAPLASelector(strategy string, items ...interface{}) *APLASelector {
+	if items == nil {
+		items = make([]interface{}, 0)
+	}
+	return &APLASelector{
+		APLACommonComponent: APLACommonComponent{Type: "Selector"},
+		Strategy:            strategy,
+		Items:               items,
+	}
+}
@@ -0,0 +1,64 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildSampleAPLADocument() *APLADocument {
+	audio := NewAPLAAudio("soundbank://soundlibrary/ui/gameshow/amzn_ui_sfx_gameshow_intro_01").
+		AddFilter(APLAFilter{Type: "Volume", Amount: "50%"}).
+		AddFilter(APLAFilter{Type: "FadeOut", Duration: 2000})
+
+	return NewAPLADocument(NewAPLAMixer(
+		NewAPLASequencer(
+			NewAPLASpeech("${payload.user.name}, welcome back."),
+			NewAPLASilence(500),
+			NewAPLASelector("randomItem",
+				NewAPLASSMLSpeech("<speak>Ready to play?</speak>"),
+				NewAPLASpeech("Let's go!"),
+			),
+		),
+		audio,
+	), "payload")
+}
+
+func TestAPLADocumentRoundTrip(t *testing.T) {
+	expected, err := ioutil.ReadFile("../resources/apla_document.json")
+	require.NoError(t, err)
+
+	actual, err := json.Marshal(buildSampleAPLADocument())
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(actual))
+
+	// Parse the sample and marshal it again
+	var document APLADocument
+	require.NoError(t, json.Unmarshal(expected, &document))
+	assert.Equal(t, "APLA", document.Type)
+	assert.Equal(t, []string{"payload"}, document.MainTemplate.Parameters)
+	remarshalled, err := json.Marshal(document)
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(remarshalled))
+}
+
+func TestAPLARenderDocumentDirective(t *testing.T) {
+	var response Response
+	d := response.AddAPLARenderDocumentDirective("welcomeToken", buildSampleAPLADocument())
+	d.AddDatasource("payload", map[string]interface{}{
+		"user": map[string]interface{}{"name": "John"},
+	})
+	require.Equal(t, 1, len(response.Directives))
+
+	bytes, err := json.Marshal(response.Directives[0])
+	require.NoError(t, err)
+	var directive map[string]interface{}
+	json.Unmarshal(bytes, &directive)
+	assert.Equal(t, "Alexa.Presentation.APLA.RenderDocument", directive["type"])
+	assert.Equal(t, "welcomeToken", directive["token"])
+	assert.Equal(t, "APLA", directive["document"].(map[string]interface{})["type"])
+	assert.Equal(t, "John", directive["datasources"].(map[string]interface{})["payload"].(map[string]interface{})["user"].(map[string]interface{})["name"])
+}
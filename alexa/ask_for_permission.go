@@ -0,0 +1,74 @@
+package alexa
+
+import "strings"
+
+// Results of a voice permission request
+const (
+	PermissionStatusAccepted    = "ACCEPTED"
+	PermissionStatusDenied      = "DENIED"
+	PermissionStatusNotAnswered = "NOT_ANSWERED"
+)
+
+// AskForPermissionsConsentRequest is the payload of the AskFor Connections.SendRequest directive.
+type AskForPermissionsConsentRequest struct {
+	ConnectionsTaskHeader
+	PermissionScope string `json:"permissionScope"`
+}
+
+// AskForPermissionResult is the answer of the customer to a voice permission request.
+type AskForPermissionResult struct {
+	PermissionScope string `json:"permissionScope"`
+	// Status is ACCEPTED, DENIED or NOT_ANSWERED
+	Status string `json:"status"`
+	// ConsentCardSent is true if the device does not support voice permissions and a AskForPermissionsConsent card was added to the response instead
+	ConsentCardSent bool `json:"-"`
+}
+
+// askForPermissionTokenPrefix marks the tokens generated by AddAskForPermissionDirective, they contain the permission scope after the prefix.
+const askForPermissionTokenPrefix = "AskForPermission:"
+
+// IsAccepted returns true if the customer granted the permission.
+func (r *AskForPermissionResult) IsAccepted() bool {
+	return r.Status == PermissionStatusAccepted
+}
+
+// AddAskForPermissionDirective asks the customer by voice to grant the permission scope, e.g. 'alexa::alerts:reminders:skill:readwrite'.
+// The answer is passed to the OnAskForPermission handler of the skill, the token is sent back in the request to correlate the answer.
+// If the token is empty a token containing the permission scope is generated, so a AskForPermissionsConsent card can be sent even if
+// the device does not support voice permissions and answers without payload.
+func (r *Response) AddAskForPermissionDirective(permissionScope, token string) *ConnectionsSendRequestDirective {
+	payload := &AskForPermissionsConsentRequest{
+		ConnectionsTaskHeader: ConnectionsTaskHeader{
+			Type:    "AskForPermissionsConsentRequest",
+			Version: "1",
+		},
+		PermissionScope: permissionScope,
+	}
+	if token == "" {
+		token = askForPermissionTokenPrefix + permissionScope
+	}
+	d := r.AddConnectionsSendRequestDirective("AskFor", payload, token)
+	// Alexa asks the customer and resumes the skill with a Connections.Response request
+	endSession := true
+	r.ShouldEndSession = &endSession
+	return d
+}
+
+// handleAskForPermission maps the Connections.Response of a AskFor directive to the result and calls the OnAskForPermission handler.
+// If the device could not ask the customer, a AskForPermissionsConsent card is added to the response. The permission scope is read from the payload
+// or from a token generated by AddAskForPermissionDirective. If the scope is unknown no card is sent and the handler has to decide.
+func (skill *Skill) handleAskForPermission(request *ConnectionsResponseRequest, response *ResponseEnvelope) {
+	result := &AskForPermissionResult{}
+	err := request.BindPayload(result)
+	if request.Status.Code != "200" || err != nil {
+		if result.PermissionScope == "" && strings.HasPrefix(request.Token, askForPermissionTokenPrefix) {
+			result.PermissionScope = strings.TrimPrefix(request.Token, askForPermissionTokenPrefix)
+		}
+		result.Status = PermissionStatusNotAnswered
+		if result.PermissionScope != "" {
+			result.ConsentCardSent = true
+			response.Response.SetAskForPermissionsConsentCard("", "", []string{result.PermissionScope})
+		}
+	}
+	skill.OnAskForPermission(request, result, response)
+}
@@ -0,0 +1,99 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAskForPermissionDirective(t *testing.T) {
+	var response Response
+	response.AddAskForPermissionDirective("alexa::alerts:reminders:skill:readwrite", "reminder-for-order-42")
+
+	bytes, _ := json.Marshal(response)
+	assert.JSONEq(t, `{
+		"shouldEndSession": true,
+		"directives": [
+			{"type": "Connections.SendRequest", "name": "AskFor", "token": "reminder-for-order-42",
+			 "payload": {"@type": "AskForPermissionsConsentRequest", "@version": "1", "permissionScope": "alexa::alerts:reminders:skill:readwrite"}}
+		]
+	}`, string(bytes))
+
+	// The permission scope is the default token
+	response = Response{}
+	directive := response.AddAskForPermissionDirective("alexa::profile:email:read", "")
+	assert.Equal(t, "AskForPermission:alexa::profile:email:read", directive.Token)
+}
+
+func TestAskForPermissionResponse(t *testing.T) {
+	askForRequest, _ := ioutil.ReadFile("../resources/connections_askfor_response_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(askForRequest, &r))
+
+	var result *AskForPermissionResult
+	var token string
+	skill := Skill{
+		OnAskForPermission: func(request *ConnectionsResponseRequest, r *AskForPermissionResult, response *ResponseEnvelope) {
+			result = r
+			token = request.Token
+		},
+		OnConnectionsResponse: func(request *ConnectionsResponseRequest, response *ResponseEnvelope) {
+			t.Fail()
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	assert.True(t, result.IsAccepted())
+	assert.Equal(t, "alexa::alerts:reminders:skill:readwrite", result.PermissionScope)
+	assert.False(t, result.ConsentCardSent)
+	assert.Nil(t, response.Response.Card)
+
+	assert.Equal(t, "reminder-for-order-42", token)
+
+	// Device does not support voice permissions, the scope is read from the payload
+	request := r.Request.(map[string]interface{})
+	request["status"] = map[string]interface{}{"code": "400", "message": "Bad Request"}
+	request["payload"] = map[string]interface{}{"permissionScope": "alexa::alerts:reminders:skill:readwrite"}
+	setRequest(t, &r, request)
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Equal(t, PermissionStatusNotAnswered, result.Status)
+	assert.Equal(t, "alexa::alerts:reminders:skill:readwrite", result.PermissionScope)
+	assert.True(t, result.ConsentCardSent)
+	assert.Equal(t, []string{"alexa::alerts:reminders:skill:readwrite"}, response.Response.Card.Permissions)
+
+	// Without payload the scope of a custom token is unknown, so no card is sent
+	delete(request, "payload")
+	setRequest(t, &r, request)
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Equal(t, PermissionStatusNotAnswered, result.Status)
+	assert.Equal(t, "", result.PermissionScope)
+	assert.False(t, result.ConsentCardSent)
+	assert.Nil(t, response.Response.Card)
+
+	// Without payload the scope is taken from a generated token
+	request["token"] = "AskForPermission:alexa::alerts:reminders:skill:readwrite"
+	setRequest(t, &r, request)
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.False(t, result.IsAccepted())
+	assert.Equal(t, PermissionStatusNotAnswered, result.Status)
+	assert.Equal(t, "alexa::alerts:reminders:skill:readwrite", result.PermissionScope)
+	assert.True(t, result.ConsentCardSent)
+	assert.Equal(t, "AskForPermissionsConsent", response.Response.Card.Type)
+	assert.Equal(t, []string{"alexa::alerts:reminders:skill:readwrite"}, response.Response.Card.Permissions)
+}
+
+// setRequest replaces the request of the envelope and decodes it again, so the changed request is used for the typed request.
+func setRequest(t *testing.T, r *RequestEnvelope, request map[string]interface{}) {
+	r.Request = request
+	data, err := json.Marshal(r)
+	require.NoError(t, err)
+	*r = RequestEnvelope{}
+	require.NoError(t, json.Unmarshal(data, r))
+}
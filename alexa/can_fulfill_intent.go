@@ -0,0 +1,84 @@
+package alexa
+
+// Values for canFulfill and canUnderstand in CanFulfillIntent responses.
+const (
+	CanFulfillYes   = "YES"
+	CanFulfillNo    = "NO"
+	CanFulfillMaybe = "MAYBE"
+)
+
+// CanFulfillIntentRequest is send by Alexa to query if the skill can understand and fulfill a intent before the skill is invoked (name-free interaction).
+// The skill must not take any action and respond with a CanFulfillIntent object.
+type CanFulfillIntentRequest struct {
+	CommonRequest
+	Intent Intent `json:"intent"`
+}
+
+// CanFulfillIntent is the answer to a CanFulfillIntentRequest.
+type CanFulfillIntent struct {
+	// CanFulfill is one of YES, NO or MAYBE
+	CanFulfill string                    `json:"canFulfill"`
+	Slots      map[string]CanFulfillSlot `json:"slots,omitempty"`
+}
+
+// CanFulfillSlot describes if the skill can understand and fulfill a single slot value.
+type CanFulfillSlot struct {
+	// CanUnderstand is one of YES, NO or MAYBE
+	CanUnderstand string `json:"canUnderstand"`
+	// CanFulfill is one of YES or NO
+	CanFulfill string `json:"canFulfill"`
+}
+
+// SetCanFulfillIntent sets the answer for a CanFulfillIntentRequest. Any present answer is overwritten.
+func (response *Response) SetCanFulfillIntent(canFulfill string) *CanFulfillIntent {
+	response.CanFulfillIntent = &CanFulfillIntent{
+		CanFulfill: canFulfill,
+	}
+	return response.CanFulfillIntent
+}
+
+// SetSlot sets the answer for the slot with the given name.
+func (c *CanFulfillIntent) SetSlot(name, canUnderstand, canFulfill string) {
+	if c.Slots == nil {
+		c.Slots = make(map[string]CanFulfillSlot)
+	}
+	c.Slots[name] = CanFulfillSlot{
+		CanUnderstand: canUnderstand,
+		CanFulfill:    canFulfill,
+	}
+}
+
+// DeriveCanFulfillIntent answers a CanFulfillIntentRequest based on the intents the skill handles. The keys of intentSlots are the names of the supported intents, the values the names of the slots the skill can fulfill for that intent.
+// A slot value is understood if it was resolved by entity resolution or has no resolutions (built-in slot types). Unresolved values are answered with MAYBE.
+func DeriveCanFulfillIntent(request *CanFulfillIntentRequest, intentSlots map[string][]string) *CanFulfillIntent {
+	supportedSlots, ok := intentSlots[request.Intent.Name]
+	if !ok {
+		return &CanFulfillIntent{CanFulfill: CanFulfillNo}
+	}
+	result := &CanFulfillIntent{CanFulfill: CanFulfillYes}
+	for name, slot := range request.Intent.Slots {
+		if slot.Value == "" {
+			continue
+		}
+		canUnderstand := CanFulfillYes
+		if slot.Resolutions != nil {
+			if value, _ := slot.ResolvedValue(); value == nil {
+				canUnderstand = CanFulfillMaybe
+			}
+		}
+		canFulfill := CanFulfillNo
+		for _, supported := range supportedSlots {
+			if supported == name {
+				canFulfill = CanFulfillYes
+			}
+		}
+		result.SetSlot(name, canUnderstand, canFulfill)
+
+		if canFulfill == CanFulfillNo {
+			result.CanFulfill = CanFulfillNo
+		} else if canUnderstand == CanFulfillMaybe && result.CanFulfill == CanFulfillYes {
+			result.CanFulfill = CanFulfillMaybe
+		}
+	}
+	return result
+}
@@ -0,0 +1,109 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readCanFulfillIntentRequest(t *testing.T) *RequestEnvelope {
+	canFulfillRequest, _ := ioutil.ReadFile("../resources/can_fulfill_intent_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(canFulfillRequest, &r))
+	return &r
+}
+
+func TestCanFulfillIntentHandler(t *testing.T) {
+	r := readCanFulfillIntentRequest(t)
+	skill := Skill{
+		OnCanFulfillIntent: func(request *CanFulfillIntentRequest, response *ResponseEnvelope) {
+			assert.Equal(t, "FIND_RESTAURANT", request.Intent.Name)
+			assert.Equal(t, "Chinese", request.Intent.Slots["Cuisine"].Value)
+			c := response.Response.SetCanFulfillIntent(CanFulfillMaybe)
+			c.SetSlot("Cuisine", CanFulfillYes, CanFulfillNo)
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+
+	bytes, _ := json.Marshal(response)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	canFulfill := resp["response"].(map[string]interface{})["canFulfillIntent"].(map[string]interface{})
+	assert.Equal(t, "MAYBE", canFulfill["canFulfill"])
+	cuisine := canFulfill["slots"].(map[string]interface{})["Cuisine"].(map[string]interface{})
+	assert.Equal(t, "YES", cuisine["canUnderstand"])
+	assert.Equal(t, "NO", cuisine["canFulfill"])
+}
+
+func TestCanFulfillIntentWithoutHandler(t *testing.T) {
+	r := readCanFulfillIntentRequest(t)
+	response, err := r.handleRequest(&Skill{})
+	require.NoError(t, err)
+
+	bytes, _ := json.Marshal(response)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	canFulfill := resp["response"].(map[string]interface{})["canFulfillIntent"].(map[string]interface{})
+	assert.Equal(t, "NO", canFulfill["canFulfill"])
+}
+
+func TestDeriveCanFulfillIntent(t *testing.T) {
+	r := readCanFulfillIntentRequest(t)
+	skill := Skill{
+		CanFulfillIntents: map[string][]string{
+			"FIND_RESTAURANT": {"Cuisine", "DateTime"},
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	c := response.Response.CanFulfillIntent
+	require.NotNil(t, c)
+	assert.Equal(t, CanFulfillYes, c.CanFulfill)
+	assert.Equal(t, CanFulfillSlot{CanUnderstand: CanFulfillYes, CanFulfill: CanFulfillYes}, c.Slots["Cuisine"])
+	assert.Equal(t, CanFulfillSlot{CanUnderstand: CanFulfillYes, CanFulfill: CanFulfillYes}, c.Slots["DateTime"])
+	// Slots without value are not answered
+	_, ok := c.Slots["Price"]
+	assert.False(t, ok)
+
+	// Slot the skill can not fulfill
+	skill.CanFulfillIntents["FIND_RESTAURANT"] = []string{"Cuisine"}
+	response, _ = r.handleRequest(&skill)
+	assert.Equal(t, CanFulfillNo, response.Response.CanFulfillIntent.CanFulfill)
+	assert.Equal(t, CanFulfillNo, response.Response.CanFulfillIntent.Slots["DateTime"].CanFulfill)
+
+	// Unknown intent
+	delete(skill.CanFulfillIntents, "FIND_RESTAURANT")
+	response, _ = r.handleRequest(&skill)
+	assert.Equal(t, CanFulfillNo, response.Response.CanFulfillIntent.CanFulfill)
+	assert.Empty(t, response.Response.CanFulfillIntent.Slots)
+}
+
+func TestDeriveCanFulfillIntentUnresolvedSlot(t *testing.T) {
+	resolutions := &SlotResolutions{
+		ResolutionsPerAuthority: []SlotResolution{
+			{Authority: "amzn1.er-authority.echo-sdk.amzn1.ask.skill.0000.Cuisine"},
+		},
+	}
+	resolutions.ResolutionsPerAuthority[0].Status.Code = "ER_SUCCESS_NO_MATCH"
+	request := &CanFulfillIntentRequest{
+		Intent: Intent{
+			Name: "FIND_RESTAURANT",
+			Slots: map[string]IntentSlot{
+				"Cuisine": {
+					Name:        "Cuisine",
+					Value:       "martian",
+					Resolutions: resolutions,
+				},
+			},
+		},
+	}
+
+	c := DeriveCanFulfillIntent(request, map[string][]string{"FIND_RESTAURANT": {"Cuisine"}})
+	assert.Equal(t, CanFulfillMaybe, c.CanFulfill)
+	assert.Equal(t, CanFulfillMaybe, c.Slots["Cuisine"].CanUnderstand)
+	assert.Equal(t, CanFulfillYes, c.Slots["Cuisine"].CanFulfill)
+}
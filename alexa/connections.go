@@ -0,0 +1,238 @@
+package alexa
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ConnectionsSendRequestDirective sends a request to Alexa or another skill, e.g. to start a in-skill purchase.
+// The session ends and the skill receives the result as Connections.Response request.
+type ConnectionsSendRequestDirective struct {
+	Type    string      `json:"type"`
+	Name    string      `json:"name"`
+	Payload interface{} `json:"payload"`
+	// Token is passed back in the Connections.Response request
+	Token string `json:"token"`
+}
+
+// ConnectionsResponseRequest is send with the result of a Connections.SendRequest directive.
+type ConnectionsResponseRequest struct {
+	CommonRequest
+	// Name of the request, e.g. Buy, Upsell or Cancel
+	Name   string            `json:"name"`
+	Status ConnectionsStatus `json:"status"`
+	// Payload contains the result of the request. Use BindPayload to map it to a struct.
+	Payload json.RawMessage `json:"payload,omitempty"`
+	Token   string          `json:"token"`
+}
+
+// ConnectionsStatus is the status of a finished connection.
+type ConnectionsStatus struct {
+	// Code is a HTTP status code, 200 if the request was handled
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ConnectionsStartConnectionDirective starts a task provided by Alexa or another skill, e.g. printing or a custom task of a provider skill.
+// The skill receives the result as SessionResumedRequest if OnCompletion is RESUME_SESSION.
+type ConnectionsStartConnectionDirective struct {
+	Type string `json:"type"`
+	// URI of the task, e.g. 'connection://AMAZON.PrintPDF/1'
+	URI   string      `json:"uri"`
+	Input interface{} `json:"input,omitempty"`
+	// Token is passed back in the SessionResumedRequest
+	Token string `json:"token,omitempty"`
+	// OnCompletion is RESUME_SESSION or SEND_ERRORS_ONLY
+	OnCompletion string `json:"onCompletion,omitempty"`
+}
+
+// OnCompletion behaviors of a ConnectionsStartConnectionDirective
+const (
+	ConnectionsOnCompletionResumeSession  = "RESUME_SESSION"
+	ConnectionsOnCompletionSendErrorsOnly = "SEND_ERRORS_ONLY"
+)
+
+// ConnectionsTaskHeader contains the type information every task payload of Amazon starts with.
+// It is embedded in the task payloads like PrintPDFTask.
+type ConnectionsTaskHeader struct {
+	Context string `json:"@context,omitempty"`
+	Type    string `json:"@type"`
+	Version string `json:"@version"`
+}
+
+// PrintPDFTask prints a PDF document (connection://AMAZON.PrintPDF/1).
+type PrintPDFTask struct {
+	ConnectionsTaskHeader
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+}
+
+// NewPrintPDFTask creates a task to print the PDF document available at the given url.
+func NewPrintPDFTask(title, description, url string) *PrintPDFTask {
+	return &PrintPDFTask{
+		ConnectionsTaskHeader: ConnectionsTaskHeader{
+			Type:    "PrintPDFRequest",
+			Version: "1",
+		},
+		Title:       title,
+		Description: description,
+		URL:         url,
+	}
+}
+
+// ScheduleFoodEstablishmentReservationTask schedules a reservation at a restaurant with the Connections.SendRequest name 'ScheduleFoodEstablishmentReservation'.
+type ScheduleFoodEstablishmentReservationTask struct {
+	ConnectionsTaskHeader
+	StartTime  string `json:"startTime,omitempty"`
+	PartySize  int    `json:"partySize,omitempty"`
+	Restaurant struct {
+		Type     string `json:"@type"`
+		Name     string `json:"name"`
+		Location struct {
+			Type            string `json:"@type"`
+			StreetAddress   string `json:"streetAddress,omitempty"`
+			AddressLocality string `json:"addressLocality,omitempty"`
+			AddressRegion   string `json:"addressRegion,omitempty"`
+			PostalCode      string `json:"postalCode,omitempty"`
+		} `json:"location"`
+	} `json:"restaurant"`
+}
+
+// NewScheduleFoodEstablishmentReservationTask creates a task to reserve a table at the named restaurant.
+func NewScheduleFoodEstablishmentReservationTask(restaurant string, startTime time.Time, partySize int) *ScheduleFoodEstablishmentReservationTask {
+	task := &ScheduleFoodEstablishmentReservationTask{
+		ConnectionsTaskHeader: ConnectionsTaskHeader{
+			Context: "http://schema.org",
+			Type:    "ScheduleFoodEstablishmentReservationRequest",
+			Version: "1",
+		},
+		StartTime: startTime.Format(time.RFC3339),
+		PartySize: partySize,
+	}
+	task.Restaurant.Type = "Restaurant"
+	task.Restaurant.Name = restaurant
+	task.Restaurant.Location.Type = "PostalAddress"
+	return task
+}
+
+// SessionResumedRequest is send if a task started with a ConnectionsStartConnectionDirective finished and the session is resumed.
+type SessionResumedRequest struct {
+	CommonRequest
+	Cause struct {
+		// Type is ConnectionCompleted
+		Type   string            `json:"type"`
+		Token  string            `json:"token"`
+		Status ConnectionsStatus `json:"status"`
+		// Result of the task. Use BindResult to map it to a struct.
+		Result json.RawMessage `json:"result,omitempty"`
+	} `json:"cause"`
+}
+
+var errMissingConnectionsResult = errors.New("Resumed session has no task result")
+
+// BindResult maps the result of the task to the given struct using the json tags of the struct.
+func (r *SessionResumedRequest) BindResult(target interface{}) error {
+	if len(r.Cause.Result) == 0 {
+		return errMissingConnectionsResult
+	}
+	return json.Unmarshal(r.Cause.Result, target)
+}
+
+// AddConnectionsSendRequestDirective creates a new directive to send the request with the given name and payload and adds it to the response.
+// The token is passed back in the Connections.Response request.
+func (r *Response) AddConnectionsSendRequestDirective(name string, payload interface{}, token string) *ConnectionsSendRequestDirective {
+	d := &ConnectionsSendRequestDirective{
+		Type:    "Connections.SendRequest",
+		Name:    name,
+		Payload: payload,
+		Token:   token,
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// AddConnectionsStartConnectionDirective creates a new directive to start the task with the given uri and input and adds it to the response.
+// The session is resumed with a SessionResumedRequest when the task is completed.
+func (r *Response) AddConnectionsStartConnectionDirective(uri string, input interface{}, token string) *ConnectionsStartConnectionDirective {
+	d := &ConnectionsStartConnectionDirective{
+		Type:         "Connections.StartConnection",
+		URI:          uri,
+		Input:        input,
+		Token:        token,
+		OnCompletion: ConnectionsOnCompletionResumeSession,
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// SetOnCompletion sets if the session is resumed after the task (RESUME_SESSION) or only if it failed (SEND_ERRORS_ONLY).
+func (d *ConnectionsStartConnectionDirective) SetOnCompletion(onCompletion string) *ConnectionsStartConnectionDirective {
+	d.OnCompletion = onCompletion
+	return d
+}
+
+// Results of a in-skill purchase
+const (
+	PurchaseResultAccepted         = "ACCEPTED"
+	PurchaseResultDeclined         = "DECLINED"
+	PurchaseResultAlreadyPurchased = "ALREADY_PURCHASED"
+	PurchaseResultError            = "ERROR"
+)
+
+// PurchaseResult is the payload of the Connections.Response request for Buy, Upsell and Cancel requests.
+type PurchaseResult struct {
+	PurchaseResult string `json:"purchaseResult"`
+	ProductID      string `json:"productId"`
+	Message        string `json:"message,omitempty"`
+}
+
+var errMissingConnectionsPayload = errors.New("Connections response has no payload")
+
+// BindPayload maps the payload of the response to the given struct using the json tags of the struct.
+func (r *ConnectionsResponseRequest) BindPayload(target interface{}) error {
+	if len(r.Payload) == 0 {
+		return errMissingConnectionsPayload
+	}
+	return json.Unmarshal(r.Payload, target)
+}
+
+// PurchaseResult returns the result of a Buy, Upsell or Cancel request.
+func (r *ConnectionsResponseRequest) PurchaseResult() (*PurchaseResult, error) {
+	var result PurchaseResult
+	err := r.BindPayload(&result)
+	return &result, err
+}
+
+type inSkillProductPayload struct {
+	InSkillProduct struct {
+		ProductID string `json:"productId"`
+	} `json:"InSkillProduct"`
+	UpsellMessage string `json:"upsellMessage,omitempty"`
+}
+
+func (r *Response) addPurchaseDirective(name, productID, upsellMessage, token string) *ConnectionsSendRequestDirective {
+	payload := &inSkillProductPayload{UpsellMessage: upsellMessage}
+	payload.InSkillProduct.ProductID = productID
+	d := r.AddConnectionsSendRequestDirective(name, payload, token)
+	// The purchase flow takes over the conversation and the skill is resumed with a Connections.Response request
+	endSession := true
+	r.ShouldEndSession = &endSession
+	return d
+}
+
+// AddBuyDirective starts the purchase flow for the in-skill product. The token is passed back in the Connections.Response request.
+func (r *Response) AddBuyDirective(productID, token string) *ConnectionsSendRequestDirective {
+	return r.addPurchaseDirective("Buy", productID, "", token)
+}
+
+// AddUpsellDirective offers the in-skill product to the customer with the upsell message.
+func (r *Response) AddUpsellDirective(productID, upsellMessage, token string) *ConnectionsSendRequestDirective {
+	return r.addPurchaseDirective("Upsell", productID, upsellMessage, token)
+}
+
+// AddCancelDirective starts the cancel flow for the in-skill product, e.g. to cancel a subscription.
+func (r *Response) AddCancelDirective(productID, token string) *ConnectionsSendRequestDirective {
+	return r.addPurchaseDirective("Cancel", productID, "", token)
+}
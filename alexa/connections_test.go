@@ -0,0 +1,112 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPurchaseDirectives(t *testing.T) {
+	var response Response
+	response.AddBuyDirective("amzn1.adg.product.0000", "buyToken")
+	response.AddUpsellDirective("amzn1.adg.product.0000", "Do you want to know more?", "upsellToken")
+	response.AddCancelDirective("amzn1.adg.product.0000", "cancelToken")
+
+	bytes, _ := json.Marshal(response)
+	assert.JSONEq(t, `{
+		"shouldEndSession": true,
+		"directives": [
+			{"type": "Connections.SendRequest", "name": "Buy", "token": "buyToken",
+			 "payload": {"InSkillProduct": {"productId": "amzn1.adg.product.0000"}}},
+			{"type": "Connections.SendRequest", "name": "Upsell", "token": "upsellToken",
+			 "payload": {"InSkillProduct": {"productId": "amzn1.adg.product.0000"}, "upsellMessage": "Do you want to know more?"}},
+			{"type": "Connections.SendRequest", "name": "Cancel", "token": "cancelToken",
+			 "payload": {"InSkillProduct": {"productId": "amzn1.adg.product.0000"}}}
+		]
+	}`, string(bytes))
+}
+
+func TestConnectionsResponse(t *testing.T) {
+	responseRequest, _ := ioutil.ReadFile("../resources/connections_response_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(responseRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnConnectionsResponse: func(request *ConnectionsResponseRequest, response *ResponseEnvelope) {
+			called = true
+			assert.Equal(t, "Buy", request.Name)
+			assert.Equal(t, "200", request.Status.Code)
+			assert.Equal(t, "correlationToken", request.Token)
+			result, err := request.PurchaseResult()
+			require.NoError(t, err)
+			assert.Equal(t, PurchaseResultAccepted, result.PurchaseResult)
+			assert.Equal(t, "amzn1.adg.product.0000", result.ProductID)
+			response.Response.SetOutputSpeech("Thank you")
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, "<speak> Thank you </speak>", response.Response.OutputSpeech.Ssml)
+
+	var request ConnectionsResponseRequest
+	_, err = request.PurchaseResult()
+	assert.Equal(t, errMissingConnectionsPayload, err)
+}
+
+func TestConnectionsDirectives(t *testing.T) {
+	var response Response
+	response.AddConnectionsStartConnectionDirective("connection://AMAZON.PrintPDF/1",
+		NewPrintPDFTask("Recipe", "Pancakes", "https://example.com/recipe.pdf"), "printToken").
+		SetOnCompletion(ConnectionsOnCompletionSendErrorsOnly)
+	startTime := time.Date(2021, 6, 1, 19, 30, 0, 0, time.UTC)
+	reservation := NewScheduleFoodEstablishmentReservationTask("Pizzeria", startTime, 4)
+	reservation.Restaurant.Location.PostalCode = "10115"
+	response.AddConnectionsSendRequestDirective("ScheduleFoodEstablishmentReservation", reservation, "reservationToken")
+
+	bytes, _ := json.Marshal(response)
+	assert.JSONEq(t, `{
+		"directives": [
+			{"type": "Connections.StartConnection", "uri": "connection://AMAZON.PrintPDF/1", "token": "printToken", "onCompletion": "SEND_ERRORS_ONLY",
+			 "input": {"@type": "PrintPDFRequest", "@version": "1", "title": "Recipe", "description": "Pancakes", "url": "https://example.com/recipe.pdf"}},
+			{"type": "Connections.SendRequest", "name": "ScheduleFoodEstablishmentReservation", "token": "reservationToken",
+			 "payload": {"@context": "http://schema.org", "@type": "ScheduleFoodEstablishmentReservationRequest", "@version": "1",
+			  "startTime": "2021-06-01T19:30:00Z", "partySize": 4,
+			  "restaurant": {"@type": "Restaurant", "name": "Pizzeria", "location": {"@type": "PostalAddress", "postalCode": "10115"}}}}
+		]
+	}`, string(bytes))
+}
+
+func TestSessionResumed(t *testing.T) {
+	resumedRequest, _ := ioutil.ReadFile("../resources/session_resumed_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(resumedRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnSessionResumed: func(request *SessionResumedRequest, response *ResponseEnvelope) {
+			called = true
+			assert.Equal(t, "ConnectionCompleted", request.Cause.Type)
+			assert.Equal(t, "printToken", request.Cause.Token)
+			assert.Equal(t, "200", request.Cause.Status.Code)
+			var result struct {
+				Status string `json:"status"`
+			}
+			require.NoError(t, request.BindResult(&result))
+			assert.Equal(t, "PRINTED", result.Status)
+			response.Response.SetOutputSpeech("Your recipe is printed")
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Equal(t, "<speak> Your recipe is printed </speak>", response.Response.OutputSpeech.Ssml)
+
+	var request SessionResumedRequest
+	assert.Equal(t, errMissingConnectionsResult, request.BindResult(&struct{}{}))
+}
@@ -0,0 +1,77 @@
+package alexa
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// DialogAPIInvokedRequest is send by Alexa Conversations if a API defined in the dialog model has to be invoked by the skill.
+// The skill responds with the result of the API call in the apiResponse of the response.
+type DialogAPIInvokedRequest struct {
+	CommonRequest
+	APIRequest DialogAPIRequest `json:"apiRequest"`
+}
+
+// DialogAPIRequest contains the name of the invoked API and its arguments.
+type DialogAPIRequest struct {
+	Name string `json:"name"`
+	// Arguments contains the argument values by name. Use BindArguments to map them to a struct.
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+	// Slots contains the slot values the arguments were resolved from.
+	Slots map[string]DialogAPISlot `json:"slots,omitempty"`
+}
+
+// DialogAPISlot is a slot value of a API request including entity resolution.
+type DialogAPISlot struct {
+	// Type is Simple or List
+	Type        string           `json:"type"`
+	Value       string           `json:"value,omitempty"`
+	Resolutions *SlotResolutions `json:"resolutions,omitempty"`
+}
+
+// DialogAPIHandlers maps the name of a Alexa Conversations API to the handler implementing it.
+type DialogAPIHandlers map[string]func(*DialogAPIInvokedRequest, *ResponseEnvelope)
+
+// DialogUpdatedInputRequest is used as updated request of a DialogDelegateRequestDirective to hand the dialog over to Alexa Conversations with the given input.
+type DialogUpdatedInputRequest struct {
+	Type  string `json:"type"`
+	Input struct {
+		Name  string                `json:"name"`
+		Slots map[string]IntentSlot `json:"slots,omitempty"`
+	} `json:"input"`
+}
+
+var errMissingAPIArguments = errors.New("API request has no arguments")
+
+// BindArguments maps the arguments of the API request to the given struct using the json tags of the struct.
+func (r *DialogAPIInvokedRequest) BindArguments(target interface{}) error {
+	if len(r.APIRequest.Arguments) == 0 {
+		return errMissingAPIArguments
+	}
+	return json.Unmarshal(r.APIRequest.Arguments, target)
+}
+
+// SetAPIResponse sets the result of a API invoked by Alexa Conversations. Any present API response is overwritten.
+func (response *Response) SetAPIResponse(apiResponse interface{}) *Response {
+	response.APIResponse = apiResponse
+	return response
+}
+
+// SetUpdatedInput hands the dialog over to Alexa Conversations and triggers the dialog with the given name. Slots are passed to the dialog.
+func (d *DialogDelegateRequestDirective) SetUpdatedInput(name string, slots map[string]IntentSlot) {
+	r := &DialogUpdatedInputRequest{
+		Type: "Dialog.InputRequest",
+	}
+	r.Input.Name = name
+	r.Input.Slots = slots
+	d.UpdatedRequest = r
+}
+
+// handleDialogAPIInvoked calls the handler registered for the invoked API. If there is none the OnDialogAPIInvoked handler is used.
+func (skill *Skill) handleDialogAPIInvoked(request *DialogAPIInvokedRequest, response *ResponseEnvelope) {
+	if handler, ok := skill.DialogAPIHandlers[request.APIRequest.Name]; ok {
+		handler(request, response)
+	} else if skill.OnDialogAPIInvoked != nil {
+		skill.OnDialogAPIInvoked(request, response)
+	}
+}
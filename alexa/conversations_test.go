@@ -0,0 +1,85 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type weatherArguments struct {
+	CityName string `json:"cityName"`
+	Date     string `json:"date"`
+	Days     int    `json:"days"`
+}
+
+func TestDialogAPIInvoked(t *testing.T) {
+	apiRequest, _ := ioutil.ReadFile("../resources/dialog_api_invoked_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(apiRequest, &r))
+
+	fallbackCalled := false
+	skill := Skill{
+		DialogAPIHandlers: DialogAPIHandlers{
+			"getWeather": func(request *DialogAPIInvokedRequest, response *ResponseEnvelope) {
+				assert.Equal(t, "Dialog.API.Invoked", request.Type)
+				assert.Equal(t, "Seattle", request.APIRequest.Slots["cityName"].Value)
+				assert.Equal(t, "Simple", request.APIRequest.Slots["cityName"].Type)
+
+				var args weatherArguments
+				require.NoError(t, request.BindArguments(&args))
+				assert.Equal(t, weatherArguments{CityName: "Seattle", Date: "2021-06-01", Days: 3}, args)
+
+				response.Response.SetAPIResponse(map[string]interface{}{
+					"cityName":    args.CityName,
+					"temperature": 21,
+				})
+			},
+		},
+		OnDialogAPIInvoked: func(request *DialogAPIInvokedRequest, response *ResponseEnvelope) {
+			fallbackCalled = true
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.False(t, fallbackCalled)
+
+	bytes, _ := json.Marshal(response)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	apiResponse := resp["response"].(map[string]interface{})["apiResponse"].(map[string]interface{})
+	assert.Equal(t, "Seattle", apiResponse["cityName"])
+	assert.Equal(t, 21.0, apiResponse["temperature"])
+
+	// Unknown APIs are handled by the fallback handler
+	delete(skill.DialogAPIHandlers, "getWeather")
+	_, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, fallbackCalled)
+}
+
+func TestBindMissingArguments(t *testing.T) {
+	var request DialogAPIInvokedRequest
+	var args weatherArguments
+	assert.Equal(t, errMissingAPIArguments, request.BindArguments(&args))
+}
+
+func TestDelegateToConversations(t *testing.T) {
+	var response Response
+	d := response.AddDialogDelegateRequestDirective(DialogDelegationTargetConversations)
+	d.SetUpdatedInput("WeatherDialog", NewIntent("").SetSlotValue("cityName", "Berlin").Slots)
+
+	bytes, err := json.Marshal(response)
+	require.NoError(t, err)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	directive := resp["directives"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, "AMAZON.Conversations", directive["target"])
+	updatedRequest := directive["updatedRequest"].(map[string]interface{})
+	assert.Equal(t, "Dialog.InputRequest", updatedRequest["type"])
+	input := updatedRequest["input"].(map[string]interface{})
+	assert.Equal(t, "WeatherDialog", input["name"])
+	assert.Equal(t, "Berlin", input["slots"].(map[string]interface{})["cityName"].(map[string]interface{})["value"])
+}
@@ -0,0 +1,98 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// CustomerProfileService provides methods to read the contact information of the customer or the recognized speaker.
+// The skill needs the permissions 'alexa::profile:name:read', 'alexa::profile:given_name:read', 'alexa::profile:email:read'
+// or 'alexa::profile:mobile_number:read' for the values it reads.
+type CustomerProfileService interface {
+	// GetName gets the full name of the customer.
+	GetName(ctx context.Context, system *System) (string, error)
+	// GetGivenName gets the given name of the customer.
+	GetGivenName(ctx context.Context, system *System) (string, error)
+	// GetEmail gets the email address of the customer.
+	GetEmail(ctx context.Context, system *System) (string, error)
+	// GetMobileNumber gets the mobile number of the customer.
+	GetMobileNumber(ctx context.Context, system *System) (*ProfileMobileNumber, error)
+	// GetPersonGivenName gets the given name of the recognized speaker. It is only available if System.Person is set.
+	GetPersonGivenName(ctx context.Context, system *System) (string, error)
+	// IsNotAuthorizedError return true if the customer did not grant the permission to read the value
+	IsNotAuthorizedError(err error) bool
+	// IsNotFoundError return true if the customer did not set the value in the profile
+	IsNotFoundError(err error) bool
+}
+
+// ProfileMobileNumber is the mobile number of the customer.
+type ProfileMobileNumber struct {
+	CountryCode string `json:"countryCode"`
+	PhoneNumber string `json:"phoneNumber"`
+}
+
+var errProfileValueNotFound = errors.New("The value is not set in the customer profile")
+
+type customerProfileService struct {
+	client *ServiceClient
+}
+
+var customerProfileServiceInstance = NewCustomerProfileService(defaultServiceClient)
+
+// NewCustomerProfileService creates a customer profile service sending the requests with the given client.
+func NewCustomerProfileService(client *ServiceClient) CustomerProfileService {
+	return &customerProfileService{client: client}
+}
+
+func (s *customerProfileService) executeProfileCall(ctx context.Context, system *System, path string, targetObj interface{}) error {
+	err := s.client.Do(ctx, http.MethodGet, system.APIEndpoint, path, system.APIAccessToken, nil, targetObj)
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) && serviceErr.StatusCode == http.StatusNotFound {
+		return errProfileValueNotFound
+	}
+	return err
+}
+
+func (s *customerProfileService) getProfileValue(ctx context.Context, system *System, path string) (string, error) {
+	var value string
+	err := s.executeProfileCall(ctx, system, path, &value)
+	if err == nil && value == "" {
+		// The API answers with 204 No Content if the value is not set
+		err = errProfileValueNotFound
+	}
+	return value, err
+}
+
+func (s *customerProfileService) GetName(ctx context.Context, system *System) (string, error) {
+	return s.getProfileValue(ctx, system, "/v2/accounts/~current/settings/Profile.name")
+}
+
+func (s *customerProfileService) GetGivenName(ctx context.Context, system *System) (string, error) {
+	return s.getProfileValue(ctx, system, "/v2/accounts/~current/settings/Profile.givenName")
+}
+
+func (s *customerProfileService) GetEmail(ctx context.Context, system *System) (string, error) {
+	return s.getProfileValue(ctx, system, "/v2/accounts/~current/settings/Profile.email")
+}
+
+func (s *customerProfileService) GetMobileNumber(ctx context.Context, system *System) (*ProfileMobileNumber, error) {
+	var number ProfileMobileNumber
+	err := s.executeProfileCall(ctx, system, "/v2/accounts/~current/settings/Profile.mobileNumber", &number)
+	if err == nil && number.PhoneNumber == "" {
+		err = errProfileValueNotFound
+	}
+	return &number, err
+}
+
+func (s *customerProfileService) GetPersonGivenName(ctx context.Context, system *System) (string, error) {
+	return s.getProfileValue(ctx, system, "/v2/persons/~current/profile/givenName")
+}
+
+func (s *customerProfileService) IsNotAuthorizedError(err error) bool {
+	return errors.Is(err, errorForbidden)
+}
+
+func (s *customerProfileService) IsNotFoundError(err error) bool {
+	return err == errProfileValueNotFound
+}
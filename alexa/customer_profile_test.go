@@ -0,0 +1,83 @@
+package alexa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newCustomerProfileTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tokenOk" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/accounts/~current/settings/Profile.name":
+			w.Write([]byte(`"Jane Doe"`))
+		case "/v2/accounts/~current/settings/Profile.givenName":
+			w.Write([]byte(`"Jane"`))
+		case "/v2/accounts/~current/settings/Profile.email":
+			w.WriteHeader(http.StatusNoContent)
+		case "/v2/accounts/~current/settings/Profile.mobileNumber":
+			w.Write([]byte(`{"countryCode":"+1","phoneNumber":"5555550100"}`))
+		case "/v2/persons/~current/profile/givenName":
+			w.Write([]byte(`"John"`))
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	}))
+}
+
+func TestCustomerProfileService(t *testing.T) {
+	ts := newCustomerProfileTestServer()
+	defer ts.Close()
+
+	profileService := GetCustomerProfileService()
+	system := &System{
+		APIAccessToken: "tokenOk",
+		APIEndpoint:    ts.URL,
+	}
+
+	name, err := profileService.GetName(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane Doe", name)
+
+	givenName, err := profileService.GetGivenName(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", givenName)
+
+	number, err := profileService.GetMobileNumber(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, "+1", number.CountryCode)
+	assert.Equal(t, "5555550100", number.PhoneNumber)
+
+	personName, err := profileService.GetPersonGivenName(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, "John", personName)
+
+	// Value not set
+	_, err = profileService.GetEmail(context.Background(), system)
+	require.Error(t, err)
+	assert.True(t, profileService.IsNotFoundError(err))
+	assert.False(t, profileService.IsNotAuthorizedError(err))
+}
+
+func TestCustomerProfileServiceNotAuthorized(t *testing.T) {
+	ts := newCustomerProfileTestServer()
+	defer ts.Close()
+
+	profileService := GetCustomerProfileService()
+	system := &System{
+		APIAccessToken: "tokenNotOk",
+		APIEndpoint:    ts.URL,
+	}
+	_, err := profileService.GetEmail(context.Background(), system)
+	require.Error(t, err)
+	assert.True(t, profileService.IsNotAuthorizedError(err))
+	assert.False(t, profileService.IsNotFoundError(err))
+}
@@ -0,0 +1,292 @@
+package alexa
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Names of the interfaces in the supported interfaces of a device
+const (
+	InterfaceAudioPlayer               = "AudioPlayer"
+	InterfaceDisplay                   = "Display"
+	InterfaceVideoApp                  = "VideoApp"
+	InterfaceAPL                       = "Alexa.Presentation.APL"
+	InterfaceAPLA                      = "Alexa.Presentation.APLA"
+	InterfaceAPLT                      = "Alexa.Presentation.APLT"
+	InterfaceHTML                      = "Alexa.Presentation.HTML"
+	InterfaceGeolocation               = "Geolocation"
+	InterfaceNavigation                = "Navigation"
+	InterfaceCustomInterfaceController = "CustomInterfaceController"
+)
+
+// DisplayInterface contains the versions of the Display interface supported by the device.
+type DisplayInterface struct {
+	TemplateVersion string `json:"templateVersion,omitempty"`
+	MarkupVersion   string `json:"markupVersion,omitempty"`
+}
+
+// APLInterface contains the APL runtime information of the device.
+type APLInterface struct {
+	Runtime struct {
+		MaxVersion string `json:"maxVersion"`
+	} `json:"runtime"`
+}
+
+// Viewport describes the screen of the device. It is only present for devices with a screen.
+type Viewport struct {
+	Experiences []struct {
+		ArcMinuteWidth  int  `json:"arcMinuteWidth"`
+		ArcMinuteHeight int  `json:"arcMinuteHeight"`
+		CanRotate       bool `json:"canRotate"`
+		CanResize       bool `json:"canResize"`
+	} `json:"experiences,omitempty"`
+	// Shape is either RECTANGLE or ROUND
+	Shape              string   `json:"shape"`
+	PixelWidth         int      `json:"pixelWidth"`
+	PixelHeight        int      `json:"pixelHeight"`
+	CurrentPixelWidth  int      `json:"currentPixelWidth"`
+	CurrentPixelHeight int      `json:"currentPixelHeight"`
+	Dpi                int      `json:"dpi"`
+	Touch              []string `json:"touch,omitempty"`
+	Keyboard           []string `json:"keyboard,omitempty"`
+	// Mode is one of AUTO, HUB, MOBILE, PC, TV
+	Mode  string `json:"mode,omitempty"`
+	Video *struct {
+		Codecs []string `json:"codecs"`
+	} `json:"video,omitempty"`
+}
+
+// ViewportProfile is a coarse classification of a viewport, e.g. to select the matching APL layout.
+type ViewportProfile string
+
+const (
+	// ViewportProfileHubRoundSmall is used by round devices like the Echo Spot.
+	ViewportProfileHubRoundSmall ViewportProfile = "HUB-ROUND-SMALL"
+	// ViewportProfileHubLandscapeSmall is used by small landscape devices like the Echo Show 5.
+	ViewportProfileHubLandscapeSmall ViewportProfile = "HUB-LANDSCAPE-SMALL"
+	// ViewportProfileHubLandscapeMedium is used by landscape devices like the Echo Show.
+	ViewportProfileHubLandscapeMedium ViewportProfile = "HUB-LANDSCAPE-MEDIUM"
+	// ViewportProfileHubLandscapeLarge is used by large landscape devices like the Echo Show 2nd Gen.
+	ViewportProfileHubLandscapeLarge ViewportProfile = "HUB-LANDSCAPE-LARGE"
+	// ViewportProfileHubLandscapeXLarge is used by extra large landscape devices like the Echo Show 15.
+	ViewportProfileHubLandscapeXLarge ViewportProfile = "HUB-LANDSCAPE-XLARGE"
+	// ViewportProfileMobileLandscapeSmall is used by small mobile devices in landscape orientation.
+	ViewportProfileMobileLandscapeSmall ViewportProfile = "MOBILE-LANDSCAPE-SMALL"
+	// ViewportProfileMobilePortraitSmall is used by small mobile devices in portrait orientation.
+	ViewportProfileMobilePortraitSmall ViewportProfile = "MOBILE-PORTRAIT-SMALL"
+	// ViewportProfileMobileLandscapeMedium is used by mobile devices in landscape orientation.
+	ViewportProfileMobileLandscapeMedium ViewportProfile = "MOBILE-LANDSCAPE-MEDIUM"
+	// ViewportProfileMobilePortraitMedium is used by mobile devices in portrait orientation.
+	ViewportProfileMobilePortraitMedium ViewportProfile = "MOBILE-PORTRAIT-MEDIUM"
+	// ViewportProfileTVLandscapeXLarge is used by TVs.
+	ViewportProfileTVLandscapeXLarge ViewportProfile = "TV-LANDSCAPE-XLARGE"
+	// ViewportProfileTVPortraitMedium is used by the vertical overlay on TVs.
+	ViewportProfileTVPortraitMedium ViewportProfile = "TV-PORTRAIT-MEDIUM"
+	// ViewportProfileTVLandscapeMedium is used by the horizontal overlay on TVs.
+	ViewportProfileTVLandscapeMedium ViewportProfile = "TV-LANDSCAPE-MEDIUM"
+	// ViewportProfileUnknown is returned if the viewport does not match any other profile or the device has no screen.
+	ViewportProfileUnknown ViewportProfile = "UNKNOWN-VIEWPORT-PROFILE"
+)
+
+// Size and dpi groups used to classify viewports. The values are ordered to allow comparisons.
+const (
+	viewportSizeXSmall = iota
+	viewportSizeSmall
+	viewportSizeMedium
+	viewportSizeLarge
+	viewportSizeXLarge
+)
+
+const (
+	viewportDpiXLow = iota
+	viewportDpiLow
+	viewportDpiMedium
+	viewportDpiHigh
+	viewportDpiXHigh
+	viewportDpiXXHigh
+)
+
+func viewportSizeGroup(size int) int {
+	switch {
+	case size < 600:
+		return viewportSizeXSmall
+	case size < 960:
+		return viewportSizeSmall
+	case size < 1280:
+		return viewportSizeMedium
+	case size < 1920:
+		return viewportSizeLarge
+	}
+	return viewportSizeXLarge
+}
+
+func viewportDpiGroup(dpi int) int {
+	switch {
+	case dpi < 121:
+		return viewportDpiXLow
+	case dpi < 161:
+		return viewportDpiLow
+	case dpi < 241:
+		return viewportDpiMedium
+	case dpi < 321:
+		return viewportDpiHigh
+	case dpi < 481:
+		return viewportDpiXHigh
+	}
+	return viewportDpiXXHigh
+}
+
+// SupportsInterface returns true if the device supports the interface with the given name, e.g. InterfaceAPLT.
+func (d *Device) SupportsInterface(name string) bool {
+	_, ok := d.SupportedInterfaces[name]
+	return ok
+}
+
+// SupportsAudioPlayer returns true if the device supports the AudioPlayer interface.
+func (d *Device) SupportsAudioPlayer() bool {
+	return d.SupportsInterface(InterfaceAudioPlayer)
+}
+
+// SupportsDisplay returns true if the device supports the Display interface.
+func (d *Device) SupportsDisplay() bool {
+	return d.SupportsInterface(InterfaceDisplay)
+}
+
+// SupportsVideoApp returns true if the device supports the VideoApp interface.
+func (d *Device) SupportsVideoApp() bool {
+	return d.SupportsInterface(InterfaceVideoApp)
+}
+
+// SupportsAPL returns true if the device supports the Alexa Presentation Language.
+func (d *Device) SupportsAPL() bool {
+	return d.SupportsInterface(InterfaceAPL)
+}
+
+// DisplayInterface returns the versions of the Display interface or nil if the device does not support it.
+func (d *Device) DisplayInterface() *DisplayInterface {
+	var display DisplayInterface
+	if !d.bindInterface(InterfaceDisplay, &display) {
+		return nil
+	}
+	return &display
+}
+
+// APLInterface returns the APL runtime information or nil if the device does not support APL.
+func (d *Device) APLInterface() *APLInterface {
+	var apl APLInterface
+	if !d.bindInterface(InterfaceAPL, &apl) {
+		return nil
+	}
+	return &apl
+}
+
+// APLMaxVersion returns the maximum APL version supported by the device or a empty string if APL is not supported.
+func (d *Device) APLMaxVersion() string {
+	apl := d.APLInterface()
+	if apl == nil {
+		return ""
+	}
+	return apl.Runtime.MaxVersion
+}
+
+// bindInterface maps the attributes of a supported interface to the given struct.
+func (d *Device) bindInterface(name string, target interface{}) bool {
+	value, ok := d.SupportedInterfaces[name]
+	if !ok {
+		return false
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, target) == nil
+}
+
+// ViewportProfile returns the profile for the viewport of the requesting device.
+func (ctx *Context) ViewportProfile() ViewportProfile {
+	v := ctx.Viewport
+	if v == nil {
+		return ViewportProfileUnknown
+	}
+	width := viewportSizeGroup(v.PixelWidth)
+	height := viewportSizeGroup(v.PixelHeight)
+	dpi := viewportDpiGroup(v.Dpi)
+	landscape := v.PixelWidth > v.PixelHeight
+	portrait := v.PixelWidth < v.PixelHeight
+
+	// The ranges match the viewport profiles of the ASK SDK, so devices slightly larger than the reference devices get the same profile
+	if v.Shape == "ROUND" {
+		if dpi == viewportDpiLow && width <= viewportSizeXSmall && height <= viewportSizeXSmall {
+			return ViewportProfileHubRoundSmall
+		}
+		return ViewportProfileUnknown
+	}
+
+	switch {
+	case landscape && dpi == viewportDpiLow && width <= viewportSizeMedium && height <= viewportSizeXSmall:
+		return ViewportProfileHubLandscapeSmall
+	case landscape && dpi == viewportDpiLow && width <= viewportSizeMedium && height <= viewportSizeSmall:
+		return ViewportProfileHubLandscapeMedium
+	case landscape && dpi == viewportDpiLow && width >= viewportSizeXLarge && height >= viewportSizeMedium:
+		return ViewportProfileHubLandscapeXLarge
+	case landscape && dpi == viewportDpiLow && width >= viewportSizeLarge && height >= viewportSizeSmall:
+		return ViewportProfileHubLandscapeLarge
+	case landscape && dpi == viewportDpiMedium && width >= viewportSizeMedium && height >= viewportSizeSmall:
+		return ViewportProfileMobileLandscapeMedium
+	case portrait && dpi == viewportDpiMedium && width >= viewportSizeSmall && height >= viewportSizeMedium:
+		return ViewportProfileMobilePortraitMedium
+	case landscape && dpi == viewportDpiMedium && width >= viewportSizeSmall && height >= viewportSizeXSmall:
+		return ViewportProfileMobileLandscapeSmall
+	case portrait && dpi == viewportDpiMedium && width >= viewportSizeXSmall && height >= viewportSizeSmall:
+		return ViewportProfileMobilePortraitSmall
+	case landscape && dpi >= viewportDpiHigh && width >= viewportSizeXLarge && height >= viewportSizeMedium:
+		return ViewportProfileTVLandscapeXLarge
+	case portrait && dpi >= viewportDpiHigh && width == viewportSizeXSmall && height == viewportSizeXLarge:
+		return ViewportProfileTVPortraitMedium
+	case landscape && dpi >= viewportDpiHigh && width == viewportSizeMedium && height == viewportSizeSmall:
+		return ViewportProfileTVLandscapeMedium
+	}
+	return ViewportProfileUnknown
+}
+
+// stripUnsupportedDirectives removes all directives from the response which can not be rendered by the given device.
+func (response *Response) stripUnsupportedDirectives(device *Device) {
+	if response == nil || len(response.Directives) == 0 {
+		return
+	}
+	directives := make([]interface{}, 0, len(response.Directives))
+	for _, directive := range response.Directives {
+		if device.supportsDirective(directiveType(directive)) {
+			directives = append(directives, directive)
+		}
+	}
+	response.Directives = directives
+}
+
+// supportsDirective returns false if the directive type belongs to a interface the device does not support.
+func (d *Device) supportsDirective(directiveType string) bool {
+	switch {
+	case strings.HasPrefix(directiveType, "Display."):
+		return d.SupportsDisplay()
+	case strings.HasPrefix(directiveType, "Alexa.Presentation.APL."):
+		return d.SupportsAPL()
+	case strings.HasPrefix(directiveType, "AudioPlayer."):
+		return d.SupportsAudioPlayer()
+	case strings.HasPrefix(directiveType, "VideoApp."):
+		return d.SupportsVideoApp()
+	}
+	return true
+}
+
+// directiveType reads the type attribute of a directive.
+func directiveType(directive interface{}) string {
+	data, err := json.Marshal(directive)
+	if err != nil {
+		return ""
+	}
+	var common struct {
+		Type string `json:"type"`
+	}
+	json.Unmarshal(data, &common)
+	return common.Type
+}
@@ -1,11 +1,9 @@
 package alexa
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
 )
 
@@ -13,8 +11,12 @@ import (
 type DeviceAddressService interface {
 	// GetCountyAndPostelCode gets the country and postal code associated with a device specified by deviceId in the syste struct.
 	GetCountryAndPostalCode(system *System) (*DeviceShortAddress, error)
+	// GetCountryAndPostalCodeWithContext works like GetCountryAndPostalCode and cancels the call with the context, e.g. request.RequestContext().
+	GetCountryAndPostalCodeWithContext(ctx context.Context, system *System) (*DeviceShortAddress, error)
 	// GetFullAddress gets the full address associated with the device specified by deviceId in the system struct.
 	GetFullAddress(system *System) (*DeviceAddress, error)
+	// GetFullAddressWithContext works like GetFullAddress and cancels the call with the context.
+	GetFullAddressWithContext(ctx context.Context, system *System) (*DeviceAddress, error)
 	//IsNotAuthorizedError return true if it is a not authorized error
 	IsNotAuthorizedError(err error) bool
 }
@@ -36,52 +38,43 @@ type DeviceAddress struct {
 	DistrictOrCounty string `json:"districtOrCounty"`
 }
 
-type deviceAddressService struct{}
+type deviceAddressService struct {
+	client *ServiceClient
+}
 
-var deviceAddressServiceInstance = &deviceAddressService{}
+var deviceAddressServiceInstance = NewDeviceAddressService(defaultServiceClient)
 
 var errorForbidden = errors.New("The authentication token is invalid or doesn't have access to the resource")
 
-func (s *deviceAddressService) executeAlexaCall(url, accessToken string, targetObj interface{}) error {
-	req, _ := http.NewRequest(http.MethodGet, url, nil)
-
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-
-	resp, err := http.DefaultClient.Do(req)
-	if err != nil {
-		log.Println("Error executing http request for URL "+url, err)
-		return err
-	}
-
-	if resp.StatusCode == http.StatusForbidden {
-		return errorForbidden
-	} else if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("Unexpected StatusCode %d, X-Amnz-RequestId=%s", resp.StatusCode, resp.Header.Get("X-Amzn-RequestId"))
-	}
-
-	defer resp.Body.Close()
-	respBytes, _ := ioutil.ReadAll(resp.Body)
-	err = json.Unmarshal(respBytes, targetObj)
-	return err
+// NewDeviceAddressService creates a device address service sending the requests with the given client.
+func NewDeviceAddressService(client *ServiceClient) DeviceAddressService {
+	return &deviceAddressService{client: client}
 }
 
 func (s *deviceAddressService) GetCountryAndPostalCode(system *System) (*DeviceShortAddress, error) {
-	url := fmt.Sprintf("%s/v1/devices/%s/settings/address/countryAndPostalCode", system.APIEndpoint, system.Device.DeviceID)
+	return s.GetCountryAndPostalCodeWithContext(context.Background(), system)
+}
+
+func (s *deviceAddressService) GetCountryAndPostalCodeWithContext(ctx context.Context, system *System) (*DeviceShortAddress, error) {
+	path := fmt.Sprintf("/v1/devices/%s/settings/address/countryAndPostalCode", system.Device.DeviceID)
 	var shortAddr DeviceShortAddress
-	err := s.executeAlexaCall(url, system.APIAccessToken, &shortAddr)
+	err := s.client.Do(ctx, http.MethodGet, system.APIEndpoint, path, system.APIAccessToken, nil, &shortAddr)
 
 	return &shortAddr, err
 }
 
 func (s *deviceAddressService) GetFullAddress(system *System) (*DeviceAddress, error) {
-	url := fmt.Sprintf("%s/v1/devices/%s/settings/address", system.APIEndpoint, system.Device.DeviceID)
+	return s.GetFullAddressWithContext(context.Background(), system)
+}
+
+func (s *deviceAddressService) GetFullAddressWithContext(ctx context.Context, system *System) (*DeviceAddress, error) {
+	path := fmt.Sprintf("/v1/devices/%s/settings/address", system.Device.DeviceID)
 	var address DeviceAddress
-	err := s.executeAlexaCall(url, system.APIAccessToken, &address)
+	err := s.client.Do(ctx, http.MethodGet, system.APIEndpoint, path, system.APIAccessToken, nil, &address)
 
 	return &address, err
 }
 
 func (s *deviceAddressService) IsNotAuthorizedError(err error) bool {
-	return err == errorForbidden
+	return errors.Is(err, errorForbidden)
 }
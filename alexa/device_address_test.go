@@ -1,6 +1,7 @@
 package alexa
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -161,3 +162,16 @@ func TestPermissionsConsentCard(t *testing.T) {
 	card := resp["response"].(map[string]interface{})["card"].(map[string]interface{})
 	assert.Equal(t, "AskForPermissionsConsent", card["type"])
 }
+
+func TestDeviceAddressServiceCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"countryCode":"DE","postalCode":"10115"}`))
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	system := &System{APIAccessToken: "tokenOk", APIEndpoint: ts.URL}
+	_, err := GetDeviceAddressService().GetCountryAndPostalCodeWithContext(ctx, system)
+	assert.ErrorIs(t, err, context.Canceled)
+}
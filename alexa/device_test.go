@@ -0,0 +1,102 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSupportedInterfaces(t *testing.T) {
+	launchRequest, _ := ioutil.ReadFile("../resources/launch_request_screen.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(launchRequest, &r))
+
+	device := r.Context.System.Device
+	assert.True(t, device.SupportsAudioPlayer())
+	assert.True(t, device.SupportsDisplay())
+	assert.True(t, device.SupportsAPL())
+	assert.False(t, device.SupportsVideoApp())
+	assert.Equal(t, "1.5", device.APLMaxVersion())
+	assert.Equal(t, "1.0", device.DisplayInterface().TemplateVersion)
+	// Interfaces without a helper are kept
+	assert.True(t, device.SupportsInterface(InterfaceAPLT))
+	assert.True(t, device.SupportsInterface(InterfaceCustomInterfaceController))
+	assert.Contains(t, device.SupportedInterfaces, "Alexa.Presentation.HTML")
+
+	require.NotNil(t, r.Context.Viewport)
+	assert.Equal(t, 1024, r.Context.Viewport.PixelWidth)
+	assert.Equal(t, []string{"SINGLE"}, r.Context.Viewport.Touch)
+	assert.Equal(t, ViewportProfileHubLandscapeMedium, r.Context.ViewportProfile())
+
+	// Device without screen
+	launchRequest, _ = ioutil.ReadFile("../resources/launch_request.json")
+	r = RequestEnvelope{}
+	require.NoError(t, json.Unmarshal(launchRequest, &r))
+	device = r.Context.System.Device
+	assert.True(t, device.SupportsAudioPlayer())
+	assert.False(t, device.SupportsDisplay())
+	assert.False(t, device.SupportsAPL())
+	assert.Equal(t, "", device.APLMaxVersion())
+	assert.Nil(t, device.DisplayInterface())
+	assert.Equal(t, ViewportProfileUnknown, r.Context.ViewportProfile())
+}
+
+func TestViewportProfile(t *testing.T) {
+	profiles := []struct {
+		viewport Viewport
+		expected ViewportProfile
+	}{
+		{Viewport{Shape: "ROUND", PixelWidth: 480, PixelHeight: 480, Dpi: 160}, ViewportProfileHubRoundSmall},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 960, PixelHeight: 480, Dpi: 160}, ViewportProfileHubLandscapeSmall},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1024, PixelHeight: 600, Dpi: 160}, ViewportProfileHubLandscapeMedium},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1280, PixelHeight: 800, Dpi: 160}, ViewportProfileHubLandscapeLarge},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1920, PixelHeight: 1080, Dpi: 320}, ViewportProfileTVLandscapeXLarge},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 600, PixelHeight: 1024, Dpi: 240}, ViewportProfileMobilePortraitMedium},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1920, PixelHeight: 1080, Dpi: 160}, ViewportProfileHubLandscapeXLarge},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1100, PixelHeight: 700, Dpi: 160}, ViewportProfileHubLandscapeMedium},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1366, PixelHeight: 768, Dpi: 160}, ViewportProfileHubLandscapeLarge},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 2560, PixelHeight: 1440, Dpi: 160}, ViewportProfileHubLandscapeXLarge},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1024, PixelHeight: 600, Dpi: 240}, ViewportProfileMobileLandscapeMedium},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 960, PixelHeight: 540, Dpi: 240}, ViewportProfileMobileLandscapeSmall},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 540, PixelHeight: 960, Dpi: 240}, ViewportProfileMobilePortraitSmall},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 400, PixelHeight: 1920, Dpi: 320}, ViewportProfileTVPortraitMedium},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 960, PixelHeight: 600, Dpi: 320}, ViewportProfileTVLandscapeMedium},
+		{Viewport{Shape: "ROUND", PixelWidth: 1024, PixelHeight: 1024, Dpi: 160}, ViewportProfileUnknown},
+		{Viewport{Shape: "RECTANGLE", PixelWidth: 1024, PixelHeight: 600, Dpi: 100}, ViewportProfileUnknown},
+	}
+	for _, p := range profiles {
+		viewport := p.viewport
+		ctx := Context{Viewport: &viewport}
+		assert.Equal(t, p.expected, ctx.ViewportProfile(), "%+v", p.viewport)
+	}
+}
+
+func TestStripUnsupportedDirectives(t *testing.T) {
+	launchRequest, _ := ioutil.ReadFile("../resources/launch_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(launchRequest, &r))
+
+	skill := Skill{
+		StripUnsupportedDirectives: true,
+		OnLaunch: func(request *LaunchRequest, response *ResponseEnvelope) {
+			response.Response.AddDisplayRenderTemplateDirective("BodyTemplate1")
+			response.Response.AddAPLRenderDocumentDirective("token", map[string]interface{}{})
+			response.Response.AddAudioPlayerStopDirective()
+			response.Response.AddDialogDelegateDirective()
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Response.Directives))
+	assert.IsType(t, &AudioPlayerStopDirective{}, response.Response.Directives[0])
+	assert.IsType(t, &DialogDelegateDirective{}, response.Response.Directives[1])
+
+	// Without stripping all directives are returned
+	skill.StripUnsupportedDirectives = false
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(response.Response.Directives))
+}
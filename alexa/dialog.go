@@ -1,9 +1,10 @@
 package alexa
 
-// DisplayRenderTemplateDirective directive to render display text, images or items on an device with screen.
+// dialogCommonDirective contains the attributes all dialog directives have in common.
+// If UpdatedIntent names a different intent than the current one, the dialog continues with that intent (intent chaining).
 type dialogCommonDirective struct {
-	Type          string `json:"type"`
-	UpdatedIntent Intent `json:"updatedIntent,omitempty"`
+	Type          string  `json:"type"`
+	UpdatedIntent *Intent `json:"updatedIntent,omitempty"`
 }
 
 // DialogDelegateDirective sends Alexa a command to handle the next turn in the dialog with the user.
@@ -82,3 +83,149 @@ func (r *Response) AddDialogConfirmIntentDirective() *DialogConfirmIntentDirecti
 	r.AddDirective(d)
 	return d
 }
+
+// DialogDelegateRequestDirective hands the control of the dialog over to another dialog manager, e.g. from the skill to Alexa Conversations and back.
+type DialogDelegateRequestDirective struct {
+	Type string `json:"type"`
+	// Target is either 'skill' or 'AMAZON.Conversations'
+	Target string `json:"target"`
+	Period struct {
+		// Until must be EXPLICIT_RETURN
+		Until string `json:"until"`
+	} `json:"period"`
+	UpdatedRequest interface{} `json:"updatedRequest,omitempty"`
+}
+
+// DialogUpdatedIntentRequest is used as updated request of a DialogDelegateRequestDirective to continue the dialog with the given intent.
+type DialogUpdatedIntentRequest struct {
+	Type   string  `json:"type"`
+	Intent *Intent `json:"intent"`
+}
+
+const (
+	// DialogDelegationTargetSkill delegates the dialog to the skill.
+	DialogDelegationTargetSkill = "skill"
+	// DialogDelegationTargetConversations delegates the dialog to Alexa Conversations.
+	DialogDelegationTargetConversations = "AMAZON.Conversations"
+)
+
+// NewIntent creates a intent with the given name without slots, e.g. to chain to another intent.
+func NewIntent(name string) *Intent {
+	return &Intent{
+		Name:               name,
+		ConfirmationStatus: "NONE",
+		Slots:              make(map[string]IntentSlot),
+	}
+}
+
+// SetSlotValue sets the value of the slot with the given name. A present slot is overwritten.
+func (i *Intent) SetSlotValue(name, value string) *Intent {
+	if i.Slots == nil {
+		i.Slots = make(map[string]IntentSlot)
+	}
+	i.Slots[name] = IntentSlot{
+		Name:               name,
+		Value:              value,
+		ConfirmationStatus: "NONE",
+	}
+	return i
+}
+
+// AddDialogDelegateToIntentDirective creates a new directive to delegate the dialog to the given intent. Slots of the intent are pre-filled with the given values.
+// The intent must have a dialog model and shouldEndSession must not be true.
+func (r *Response) AddDialogDelegateToIntentDirective(intent *Intent) *DialogDelegateDirective {
+	d := r.AddDialogDelegateDirective()
+	d.UpdatedIntent = intent
+	return d
+}
+
+// AddDialogElicitSlotForIntentDirective creates a new directive to chain to the given intent and ask the user for the value of slotToElicit.
+// A prompt for the slot must be set as output speech.
+func (r *Response) AddDialogElicitSlotForIntentDirective(intent *Intent, slotToElicit string) *DialogElicitDirective {
+	d := r.AddDialogElicitSlotDirective(slotToElicit)
+	d.UpdatedIntent = intent
+	return d
+}
+
+// AddDialogDelegateRequestDirective creates a new directive to hand the dialog over to the given target until it explicitly returns.
+func (r *Response) AddDialogDelegateRequestDirective(target string) *DialogDelegateRequestDirective {
+	d := &DialogDelegateRequestDirective{
+		Type:   "Dialog.DelegateRequest",
+		Target: target,
+	}
+	d.Period.Until = "EXPLICIT_RETURN"
+	r.AddDirective(d)
+	return d
+}
+
+// SetUpdatedIntent continues the delegated dialog with the given intent.
+func (d *DialogDelegateRequestDirective) SetUpdatedIntent(intent *Intent) {
+	d.UpdatedRequest = &DialogUpdatedIntentRequest{
+		Type:   "IntentRequest",
+		Intent: intent,
+	}
+}
+
+// DialogUpdateDynamicEntitiesDirective replaces or clears the dynamic entities of custom slot types for the current session.
+// Dynamic entities are used to personalize slot values, e.g. with the users playlists or contacts.
+type DialogUpdateDynamicEntitiesDirective struct {
+	Type string `json:"type"`
+	// UpdateBehavior must be REPLACE or CLEAR
+	UpdateBehavior string                     `json:"updateBehavior"`
+	Types          []*DialogDynamicEntityType `json:"types,omitempty"`
+}
+
+// DialogDynamicEntityType contains the values of the dynamic entities for a custom slot type.
+type DialogDynamicEntityType struct {
+	// Name of the custom slot type in the interaction model
+	Name   string                     `json:"name"`
+	Values []DialogDynamicEntityValue `json:"values"`
+}
+
+// DialogDynamicEntityValue is a single slot value with its synonyms. The ID is returned in the slot resolutions.
+type DialogDynamicEntityValue struct {
+	ID   string `json:"id,omitempty"`
+	Name struct {
+		Value    string   `json:"value"`
+		Synonyms []string `json:"synonyms,omitempty"`
+	} `json:"name"`
+}
+
+// AddDialogUpdateDynamicEntitiesDirective creates a new directive to replace the dynamic entities for the current session.
+func (r *Response) AddDialogUpdateDynamicEntitiesDirective() *DialogUpdateDynamicEntitiesDirective {
+	d := &DialogUpdateDynamicEntitiesDirective{
+		Type:           "Dialog.UpdateDynamicEntities",
+		UpdateBehavior: "REPLACE",
+		Types:          make([]*DialogDynamicEntityType, 0),
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// AddDialogClearDynamicEntitiesDirective creates a new directive to clear all dynamic entities of the current session.
+func (r *Response) AddDialogClearDynamicEntitiesDirective() *DialogUpdateDynamicEntitiesDirective {
+	d := &DialogUpdateDynamicEntitiesDirective{
+		Type:           "Dialog.UpdateDynamicEntities",
+		UpdateBehavior: "CLEAR",
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// AddType adds a slot type with the given name to the directive and returns the reference to add values.
+func (d *DialogUpdateDynamicEntitiesDirective) AddType(name string) *DialogDynamicEntityType {
+	entityType := &DialogDynamicEntityType{
+		Name:   name,
+		Values: make([]DialogDynamicEntityValue, 0),
+	}
+	d.Types = append(d.Types, entityType)
+	return entityType
+}
+
+// AddValue adds a value with the given id and synonyms to the slot type.
+func (t *DialogDynamicEntityType) AddValue(id, value string, synonyms ...string) {
+	v := DialogDynamicEntityValue{ID: id}
+	v.Name.Value = value
+	v.Name.Synonyms = synonyms
+	t.Values = append(t.Values, v)
+}
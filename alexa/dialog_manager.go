@@ -0,0 +1,118 @@
+package alexa
+
+// DialogAction is the decision of a DialogManager for the current turn of a dialog.
+type DialogAction string
+
+const (
+	// DialogActionCompleted means all required slots are filled and confirmed. The skill can fulfill the intent.
+	DialogActionCompleted DialogAction = "COMPLETED"
+	// DialogActionDenied means the user denied the intent confirmation. The skill should not fulfill the intent.
+	DialogActionDenied DialogAction = "DENIED"
+	// DialogActionDelegate means a Dialog.Delegate directive was added to the response.
+	DialogActionDelegate DialogAction = "DELEGATE"
+	// DialogActionElicitSlot means a Dialog.ElicitSlot directive was added to the response.
+	DialogActionElicitSlot DialogAction = "ELICIT_SLOT"
+	// DialogActionConfirmSlot means a Dialog.ConfirmSlot directive was added to the response.
+	DialogActionConfirmSlot DialogAction = "CONFIRM_SLOT"
+	// DialogActionConfirmIntent means a Dialog.ConfirmIntent directive was added to the response.
+	DialogActionConfirmIntent DialogAction = "CONFIRM_INTENT"
+)
+
+// DialogSlot describes how a slot of the intent is handled by the DialogManager.
+type DialogSlot struct {
+	Name string
+	// Required slots are elicited if they have no value.
+	Required bool
+	// ElicitationPrompt is used to ask the user for the slot value. If empty the prompt from the interaction model is used.
+	ElicitationPrompt string
+	// Confirm requires the user to confirm the slot value before the dialog continues.
+	Confirm bool
+	// ConfirmationPrompt is used to ask the user for confirmation. If empty the prompt from the interaction model is used.
+	ConfirmationPrompt string
+}
+
+// DialogManager decides for each turn of a multi-turn dialog which dialog directive must be returned.
+// The slots are processed in the given order. Every directive carries the current intent as updated intent so slot values already provided by the user are preserved.
+type DialogManager struct {
+	Slots []DialogSlot
+	// ConfirmIntent requires the user to confirm the intent after all slots are filled.
+	ConfirmIntent bool
+	// IntentConfirmationPrompt is used to ask the user for confirmation of the intent.
+	IntentConfirmationPrompt string
+	// Delegate hands the dialog over to Alexa if the dialog is not completed and the skill has nothing to ask for.
+	Delegate bool
+}
+
+// Handle decides how the dialog continues for the given intent request and adds the necessary directive and output speech to the response.
+// If DialogActionCompleted or DialogActionDenied is returned nothing was added to the response and the skill must respond itself.
+func (m *DialogManager) Handle(request *IntentRequest, response *Response) DialogAction {
+	if request.Intent.ConfirmationStatus == "DENIED" {
+		return DialogActionDenied
+	}
+	intent := copyIntent(&request.Intent)
+
+	for _, slot := range m.Slots {
+		value := intent.Slots[slot.Name]
+		if value.ConfirmationStatus == "DENIED" {
+			// The user rejected the value, ask for it again
+			value.Value = ""
+			value.Resolutions = nil
+			value.ConfirmationStatus = "NONE"
+			intent.Slots[slot.Name] = value
+			m.elicitSlot(slot, intent, response)
+			return DialogActionElicitSlot
+		}
+		if value.Value == "" {
+			if slot.Required {
+				m.elicitSlot(slot, intent, response)
+				return DialogActionElicitSlot
+			}
+			continue
+		}
+		if slot.Confirm && value.ConfirmationStatus != "CONFIRMED" {
+			d := response.AddDialogConfirmSlotDirective(slot.Name)
+			d.UpdatedIntent = &intent
+			setDialogPrompt(response, slot.ConfirmationPrompt)
+			return DialogActionConfirmSlot
+		}
+	}
+
+	if m.ConfirmIntent && intent.ConfirmationStatus != "CONFIRMED" {
+		d := response.AddDialogConfirmIntentDirective()
+		d.UpdatedIntent = &intent
+		setDialogPrompt(response, m.IntentConfirmationPrompt)
+		return DialogActionConfirmIntent
+	}
+
+	if m.Delegate && request.DialogState != "COMPLETED" {
+		d := response.AddDialogDelegateDirective()
+		d.UpdatedIntent = &intent
+		return DialogActionDelegate
+	}
+	return DialogActionCompleted
+}
+
+func (m *DialogManager) elicitSlot(slot DialogSlot, intent Intent, response *Response) {
+	d := response.AddDialogElicitSlotDirective(slot.Name)
+	d.UpdatedIntent = &intent
+	setDialogPrompt(response, slot.ElicitationPrompt)
+}
+
+// setDialogPrompt sets the prompt as output speech and reprompt. Empty prompts are ignored.
+func setDialogPrompt(response *Response, prompt string) {
+	if prompt == "" {
+		return
+	}
+	response.SetOutputSpeech(prompt)
+	response.SetReprompt(prompt)
+}
+
+// copyIntent copies the intent including its slots, the slot map of the request is not modified.
+func copyIntent(intent *Intent) Intent {
+	c := *intent
+	c.Slots = make(map[string]IntentSlot, len(intent.Slots))
+	for name, slot := range intent.Slots {
+		c.Slots[name] = slot
+	}
+	return c
+}
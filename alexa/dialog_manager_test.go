@@ -0,0 +1,128 @@
+package alexa
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newDialogIntentRequest(dialogState, confirmationStatus string, slots ...IntentSlot) *IntentRequest {
+	request := &IntentRequest{
+		DialogState: dialogState,
+		Intent: Intent{
+			Name:               "OrderPizzaIntent",
+			ConfirmationStatus: confirmationStatus,
+			Slots:              make(map[string]IntentSlot),
+		},
+	}
+	for _, slot := range slots {
+		request.Intent.Slots[slot.Name] = slot
+	}
+	return request
+}
+
+var pizzaDialog = DialogManager{
+	Slots: []DialogSlot{
+		{Name: "size", Required: true, ElicitationPrompt: "Which size?"},
+		{Name: "topping", Required: true, Confirm: true, ConfirmationPrompt: "Is the topping correct?"},
+		{Name: "crust"},
+	},
+	ConfirmIntent:            true,
+	IntentConfirmationPrompt: "Shall I order?",
+	Delegate:                 true,
+}
+
+func TestDialogManagerElicitSlot(t *testing.T) {
+	request := newDialogIntentRequest("STARTED", "NONE",
+		IntentSlot{Name: "size", ConfirmationStatus: "NONE"},
+		IntentSlot{Name: "topping", Value: "salami", ConfirmationStatus: "NONE"},
+	)
+	var response Response
+	action := pizzaDialog.Handle(request, &response)
+
+	assert.Equal(t, DialogActionElicitSlot, action)
+	require.Equal(t, 1, len(response.Directives))
+	d := response.Directives[0].(*DialogElicitDirective)
+	assert.Equal(t, "Dialog.ElicitSlot", d.Type)
+	assert.Equal(t, "size", d.SlotToElicit)
+	assert.Equal(t, "OrderPizzaIntent", d.UpdatedIntent.Name)
+	assert.Equal(t, "salami", d.UpdatedIntent.Slots["topping"].Value)
+	assert.Equal(t, "<speak> Which size? </speak>", response.OutputSpeech.Ssml)
+	assert.Equal(t, "<speak> Which size? </speak>", response.Reprompt.OutputSpeech.Ssml)
+}
+
+func TestDialogManagerConfirmSlot(t *testing.T) {
+	request := newDialogIntentRequest("IN_PROGRESS", "NONE",
+		IntentSlot{Name: "size", Value: "large", ConfirmationStatus: "NONE"},
+		IntentSlot{Name: "topping", Value: "salami", ConfirmationStatus: "NONE"},
+	)
+	var response Response
+	action := pizzaDialog.Handle(request, &response)
+
+	assert.Equal(t, DialogActionConfirmSlot, action)
+	d := response.Directives[0].(*DialogConfirmSlotDirective)
+	assert.Equal(t, "topping", d.SlotToConfirm)
+	assert.Equal(t, "large", d.UpdatedIntent.Slots["size"].Value)
+	assert.Equal(t, "<speak> Is the topping correct? </speak>", response.OutputSpeech.Ssml)
+}
+
+func TestDialogManagerSlotDenied(t *testing.T) {
+	request := newDialogIntentRequest("IN_PROGRESS", "NONE",
+		IntentSlot{Name: "size", Value: "large", ConfirmationStatus: "NONE"},
+		IntentSlot{Name: "topping", Value: "salami", ConfirmationStatus: "DENIED"},
+	)
+	var response Response
+	action := pizzaDialog.Handle(request, &response)
+
+	assert.Equal(t, DialogActionElicitSlot, action)
+	d := response.Directives[0].(*DialogElicitDirective)
+	assert.Equal(t, "topping", d.SlotToElicit)
+	assert.Equal(t, "", d.UpdatedIntent.Slots["topping"].Value)
+	assert.Equal(t, "NONE", d.UpdatedIntent.Slots["topping"].ConfirmationStatus)
+	// No prompt configured, the prompt of the interaction model is used
+	assert.Nil(t, response.OutputSpeech)
+	// The request is not modified
+	assert.Equal(t, "salami", request.Intent.Slots["topping"].Value)
+}
+
+func TestDialogManagerConfirmIntent(t *testing.T) {
+	request := newDialogIntentRequest("IN_PROGRESS", "NONE",
+		IntentSlot{Name: "size", Value: "large", ConfirmationStatus: "NONE"},
+		IntentSlot{Name: "topping", Value: "salami", ConfirmationStatus: "CONFIRMED"},
+	)
+	var response Response
+	action := pizzaDialog.Handle(request, &response)
+
+	assert.Equal(t, DialogActionConfirmIntent, action)
+	assert.IsType(t, &DialogConfirmIntentDirective{}, response.Directives[0])
+	assert.Equal(t, "<speak> Shall I order? </speak>", response.OutputSpeech.Ssml)
+}
+
+func TestDialogManagerDelegateAndComplete(t *testing.T) {
+	request := newDialogIntentRequest("IN_PROGRESS", "CONFIRMED",
+		IntentSlot{Name: "size", Value: "large", ConfirmationStatus: "NONE"},
+		IntentSlot{Name: "topping", Value: "salami", ConfirmationStatus: "CONFIRMED"},
+	)
+	var response Response
+	action := pizzaDialog.Handle(request, &response)
+	assert.Equal(t, DialogActionDelegate, action)
+	d := response.Directives[0].(*DialogDelegateDirective)
+	assert.Equal(t, "CONFIRMED", d.UpdatedIntent.ConfirmationStatus)
+
+	request.DialogState = "COMPLETED"
+	response = Response{}
+	action = pizzaDialog.Handle(request, &response)
+	assert.Equal(t, DialogActionCompleted, action)
+	assert.Empty(t, response.Directives)
+}
+
+func TestDialogManagerIntentDenied(t *testing.T) {
+	request := newDialogIntentRequest("IN_PROGRESS", "DENIED",
+		IntentSlot{Name: "size", Value: "large", ConfirmationStatus: "NONE"},
+	)
+	var response Response
+	action := pizzaDialog.Handle(request, &response)
+	assert.Equal(t, DialogActionDenied, action)
+	assert.Empty(t, response.Directives)
+}
@@ -51,3 +51,95 @@ func TestDialogDirectives(t *testing.T) {
 	assert.Equal(t, "Dialog.ElicitSlot", directives[3].(map[string]interface{})["type"])
 	assert.Equal(t, "slot2", directives[3].(map[string]interface{})["slotToElicit"])
 }
+
+func TestDialogUpdateDynamicEntitiesDirective(t *testing.T) {
+	var response Response
+	d := response.AddDialogUpdateDynamicEntitiesDirective()
+	playlists := d.AddType("Playlist")
+	playlists.AddValue("playlist-4711", "my running mix", "running", "jogging")
+	genres := d.AddType("Genre")
+	genres.AddValue("", "lofi")
+	playlists.AddValue("playlist-0815", "sunday morning")
+	response.AddDialogClearDynamicEntitiesDirective()
+
+	bytes, err := json.Marshal(response)
+	assert.NoError(t, err)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	directives := resp["directives"].([]interface{})
+	assert.Equal(t, 2, len(directives))
+
+	update := directives[0].(map[string]interface{})
+	assert.Equal(t, "Dialog.UpdateDynamicEntities", update["type"])
+	assert.Equal(t, "REPLACE", update["updateBehavior"])
+	types := update["types"].([]interface{})
+	assert.Equal(t, 2, len(types))
+	playlistValues := types[0].(map[string]interface{})["values"].([]interface{})
+	assert.Equal(t, 2, len(playlistValues))
+	first := playlistValues[0].(map[string]interface{})
+	assert.Equal(t, "playlist-4711", first["id"])
+	assert.Equal(t, "my running mix", first["name"].(map[string]interface{})["value"])
+	assert.Equal(t, []interface{}{"running", "jogging"}, first["name"].(map[string]interface{})["synonyms"])
+	assert.Nil(t, types[1].(map[string]interface{})["values"].([]interface{})[0].(map[string]interface{})["id"])
+
+	clear := directives[1].(map[string]interface{})
+	assert.Equal(t, "CLEAR", clear["updateBehavior"])
+	assert.Nil(t, clear["types"])
+}
+
+func TestDialogIntentChaining(t *testing.T) {
+	var response Response
+	// Directives without updated intent must not contain an empty intent object
+	response.AddDialogDelegateDirective()
+	response.AddDialogDelegateToIntentDirective(NewIntent("OrderPizzaIntent").SetSlotValue("size", "large"))
+	response.AddDialogElicitSlotForIntentDirective(NewIntent("BookTableIntent"), "time")
+
+	bytes, err := json.Marshal(response)
+	assert.NoError(t, err)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	directives := resp["directives"].([]interface{})
+	assert.Equal(t, 3, len(directives))
+
+	_, ok := directives[0].(map[string]interface{})["updatedIntent"]
+	assert.False(t, ok)
+
+	delegate := directives[1].(map[string]interface{})
+	assert.Equal(t, "Dialog.Delegate", delegate["type"])
+	updatedIntent := delegate["updatedIntent"].(map[string]interface{})
+	assert.Equal(t, "OrderPizzaIntent", updatedIntent["name"])
+	assert.Equal(t, "NONE", updatedIntent["confirmationStatus"])
+	size := updatedIntent["slots"].(map[string]interface{})["size"].(map[string]interface{})
+	assert.Equal(t, "size", size["name"])
+	assert.Equal(t, "large", size["value"])
+
+	elicit := directives[2].(map[string]interface{})
+	assert.Equal(t, "Dialog.ElicitSlot", elicit["type"])
+	assert.Equal(t, "time", elicit["slotToElicit"])
+	assert.Equal(t, "BookTableIntent", elicit["updatedIntent"].(map[string]interface{})["name"])
+}
+
+func TestDialogDelegateRequestDirective(t *testing.T) {
+	var response Response
+	response.AddDialogDelegateRequestDirective(DialogDelegationTargetConversations)
+	d := response.AddDialogDelegateRequestDirective(DialogDelegationTargetSkill)
+	d.SetUpdatedIntent(NewIntent("OrderPizzaIntent"))
+
+	bytes, err := json.Marshal(response)
+	assert.NoError(t, err)
+	var resp map[string]interface{}
+	json.Unmarshal(bytes, &resp)
+	directives := resp["directives"].([]interface{})
+
+	conversations := directives[0].(map[string]interface{})
+	assert.Equal(t, "Dialog.DelegateRequest", conversations["type"])
+	assert.Equal(t, "AMAZON.Conversations", conversations["target"])
+	assert.Equal(t, "EXPLICIT_RETURN", conversations["period"].(map[string]interface{})["until"])
+	assert.Nil(t, conversations["updatedRequest"])
+
+	skill := directives[1].(map[string]interface{})
+	assert.Equal(t, "skill", skill["target"])
+	updatedRequest := skill["updatedRequest"].(map[string]interface{})
+	assert.Equal(t, "IntentRequest", updatedRequest["type"])
+	assert.Equal(t, "OrderPizzaIntent", updatedRequest["intent"].(map[string]interface{})["name"])
+}
@@ -0,0 +1,76 @@
+package alexa
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// DirectiveService sends directives to the device while the skill is still processing a request.
+// It is used for progressive responses, e.g. to keep the user engaged while the skill calls a slow backend.
+type DirectiveService interface {
+	// SendProgressiveResponse speaks the given text or SSML document to the user and blocks until the directive was sent. The request context of the given request is honored.
+	SendProgressiveResponse(request *CommonRequest, speech string) error
+	// StartProgressiveResponse sends the progressive response concurrently to the handler. The returned channel receives the result and is closed afterwards.
+	StartProgressiveResponse(request *CommonRequest, speech string) <-chan error
+	// IsNotAuthorizedError return true if the access token of the request is invalid
+	IsNotAuthorizedError(err error) bool
+}
+
+// DirectiveServiceRequest is the body sent to the directive service.
+type DirectiveServiceRequest struct {
+	Header struct {
+		// RequestID of the request the directive belongs to
+		RequestID string `json:"requestId"`
+	} `json:"header"`
+	Directive interface{} `json:"directive"`
+}
+
+// VoicePlayerSpeakDirective speaks the given SSML to the user as progressive response.
+type VoicePlayerSpeakDirective struct {
+	Type   string `json:"type"`
+	Speech string `json:"speech"`
+}
+
+type directiveService struct {
+	client *ServiceClient
+}
+
+var directiveServiceInstance = NewDirectiveService(defaultServiceClient)
+
+// NewDirectiveService creates a directive service sending the requests with the given client.
+func NewDirectiveService(client *ServiceClient) DirectiveService {
+	return &directiveService{client: client}
+}
+
+func (s *directiveService) SendProgressiveResponse(request *CommonRequest, speech string) error {
+	var body DirectiveServiceRequest
+	body.Header.RequestID = request.RequestID
+	body.Directive = &VoicePlayerSpeakDirective{
+		Type:   "VoicePlayer.Speak",
+		Speech: toSSML(speech),
+	}
+	system := request.Context.System
+	return s.client.Do(request.RequestContext(), http.MethodPost, system.APIEndpoint, "/v1/directives", system.APIAccessToken, &body, nil)
+}
+
+func (s *directiveService) StartProgressiveResponse(request *CommonRequest, speech string) <-chan error {
+	result := make(chan error, 1)
+	go func() {
+		defer close(result)
+		result <- s.SendProgressiveResponse(request, speech)
+	}()
+	return result
+}
+
+func (s *directiveService) IsNotAuthorizedError(err error) bool {
+	return errors.Is(err, errorForbidden)
+}
+
+// toSSML wraps the text in a speak element unless it already is a SSML document.
+func toSSML(speech string) string {
+	if strings.HasPrefix(strings.TrimSpace(speech), "<speak>") {
+		return speech
+	}
+	return "<speak> " + speech + " </speak>"
+}
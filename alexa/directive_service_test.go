@@ -0,0 +1,105 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProgressiveResponseRequest(endpoint, token string) *CommonRequest {
+	return &CommonRequest{
+		RequestID: "requestID",
+		Context: &Context{
+			System: System{
+				APIAccessToken: token,
+				APIEndpoint:    endpoint,
+			},
+		},
+	}
+}
+
+func TestProgressiveResponse(t *testing.T) {
+	received := make(chan DirectiveServiceRequest, 1)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/directives", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer tokenOk" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		var body DirectiveServiceRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		received <- body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	directiveService := GetDirectiveService()
+	request := newProgressiveResponseRequest(ts.URL, "tokenOk")
+
+	err := <-directiveService.StartProgressiveResponse(request, "Please wait")
+	assert.NoError(t, err)
+	body := <-received
+	assert.Equal(t, "requestID", body.Header.RequestID)
+	directive := body.Directive.(map[string]interface{})
+	assert.Equal(t, "VoicePlayer.Speak", directive["type"])
+	assert.Equal(t, "<speak> Please wait </speak>", directive["speech"])
+
+	// SSML is not wrapped again
+	err = directiveService.SendProgressiveResponse(request, "<speak>Please <break time=\"1s\"/> wait</speak>")
+	assert.NoError(t, err)
+	body = <-received
+	assert.Equal(t, "<speak>Please <break time=\"1s\"/> wait</speak>", body.Directive.(map[string]interface{})["speech"])
+
+	// Invalid access token
+	request = newProgressiveResponseRequest(ts.URL, "tokenNotOk")
+	err = directiveService.SendProgressiveResponse(request, "Please wait")
+	assert.Error(t, err)
+	assert.True(t, directiveService.IsNotAuthorizedError(err))
+}
+
+func TestProgressiveResponseCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	request := newProgressiveResponseRequest(ts.URL, "tokenOk")
+	request.setRequestContext(ctx)
+
+	result := GetDirectiveService().StartProgressiveResponse(request, "Please wait")
+	cancel()
+	select {
+	case err := <-result:
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, context.Canceled)
+	case <-time.After(2 * time.Second):
+		t.Fatal("Progressive response was not canceled")
+	}
+}
+
+func TestRequestContext(t *testing.T) {
+	var request CommonRequest
+	assert.Equal(t, context.Background(), request.RequestContext())
+
+	type contextKey string
+	ctx := context.WithValue(context.Background(), contextKey("key"), "value")
+	envelope := RequestEnvelope{
+		Request: map[string]interface{}{"type": "LaunchRequest"},
+		ctx:     ctx,
+	}
+	var launchRequest LaunchRequest
+	require.NoError(t, envelope.getTypedRequest(&launchRequest))
+	assert.Equal(t, ctx, launchRequest.RequestContext())
+}
@@ -0,0 +1,108 @@
+package alexa
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	// dynamicAuthorityPrefix is the prefix of the authority for values provided with Dialog.UpdateDynamicEntities.
+	dynamicAuthorityPrefix = "amzn1.er-authority.echo-sdk.dynamic."
+	// staticAuthorityPrefix is the prefix of the authority for values defined in the interaction model.
+	staticAuthorityPrefix = "amzn1.er-authority.echo-sdk."
+)
+
+// SlotResolutions contains the results of entity resolution for a slot value.
+type SlotResolutions struct {
+	ResolutionsPerAuthority []SlotResolution `json:"resolutionsPerAuthority"`
+}
+
+// SlotResolution contains the resolved values of a single authority. Static slot values and dynamic entities are resolved by different authorities.
+type SlotResolution struct {
+	Authority string `json:"authority"`
+	Status    struct {
+		// Code is one of ER_SUCCESS_MATCH, ER_SUCCESS_NO_MATCH, ER_ERROR_TIMEOUT or ER_ERROR_EXCEPTION
+		Code string `json:"code"`
+	} `json:"status"`
+	Values []struct {
+		Value SlotResolutionValue `json:"value"`
+	} `json:"values,omitempty"`
+}
+
+// SlotResolutionValue is a resolved slot value with the name and id defined in the slot type.
+type SlotResolutionValue struct {
+	Name string `json:"name"`
+	ID   string `json:"id"`
+}
+
+// IsMatch returns true if the authority resolved the slot value.
+func (r *SlotResolution) IsMatch() bool {
+	return r.Status.Code == "ER_SUCCESS_MATCH" && len(r.Values) > 0
+}
+
+// IsDynamic returns true if the authority resolved the value against dynamic entities.
+func (r *SlotResolution) IsDynamic() bool {
+	return strings.HasPrefix(r.Authority, dynamicAuthorityPrefix)
+}
+
+// TypedResolutions returns the entity resolution results of the slot or nil if the slot has none.
+func (s *IntentSlot) TypedResolutions() *SlotResolutions {
+	switch resolutions := s.Resolutions.(type) {
+	case nil:
+		return nil
+	case *SlotResolutions:
+		return resolutions
+	case SlotResolutions:
+		return &resolutions
+	}
+	data, err := json.Marshal(s.Resolutions)
+	if err != nil {
+		return nil
+	}
+	var resolutions SlotResolutions
+	if err := json.Unmarshal(data, &resolutions); err != nil {
+		return nil
+	}
+	return &resolutions
+}
+
+// DynamicResolution returns the resolution of the dynamic entities authority or nil if there is none.
+func (s *IntentSlot) DynamicResolution() *SlotResolution {
+	resolutions := s.TypedResolutions()
+	if resolutions == nil {
+		return nil
+	}
+	for i := range resolutions.ResolutionsPerAuthority {
+		if resolutions.ResolutionsPerAuthority[i].IsDynamic() {
+			return &resolutions.ResolutionsPerAuthority[i]
+		}
+	}
+	return nil
+}
+
+// StaticResolution returns the resolution of the authority for the static slot values of the interaction model or nil if there is none.
+func (s *IntentSlot) StaticResolution() *SlotResolution {
+	resolutions := s.TypedResolutions()
+	if resolutions == nil {
+		return nil
+	}
+	for i := range resolutions.ResolutionsPerAuthority {
+		r := &resolutions.ResolutionsPerAuthority[i]
+		if strings.HasPrefix(r.Authority, staticAuthorityPrefix) && !r.IsDynamic() {
+			return r
+		}
+	}
+	return nil
+}
+
+// ResolvedValue returns the first resolved value of the slot. Matches of dynamic entities are preferred over static ones.
+// The second return value is true if the value was resolved against dynamic entities. If the slot could not be resolved nil is returned.
+func (s *IntentSlot) ResolvedValue() (*SlotResolutionValue, bool) {
+	if r := s.DynamicResolution(); r != nil && r.IsMatch() {
+		return &r.Values[0].Value, true
+	}
+	if r := s.StaticResolution(); r != nil && r.IsMatch() {
+		return &r.Values[0].Value, false
+	}
+	return nil, false
+}
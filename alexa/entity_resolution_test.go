@@ -0,0 +1,62 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlotResolutions(t *testing.T) {
+	intentRequest, _ := ioutil.ReadFile("../resources/intent_request_dynamic_entities.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(intentRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnIntent: func(request *IntentRequest, response *ResponseEnvelope) {
+			called = true
+			// Dynamic match is preferred
+			playlist := request.Intent.Slots["playlist"]
+			value, dynamic := playlist.ResolvedValue()
+			require.NotNil(t, value)
+			assert.True(t, dynamic)
+			assert.Equal(t, "playlist-4711", value.ID)
+			assert.Equal(t, "my running mix", value.Name)
+			assert.Equal(t, "RUNNING", playlist.StaticResolution().Values[0].Value.ID)
+			// The resolutions are decoded as map and read typed
+			assert.IsType(t, map[string]interface{}{}, playlist.Resolutions)
+			require.NotNil(t, playlist.TypedResolutions())
+			assert.Equal(t, 2, len(playlist.TypedResolutions().ResolutionsPerAuthority))
+
+			// No dynamic match, static match is used
+			genre := request.Intent.Slots["genre"]
+			assert.False(t, genre.DynamicResolution().IsMatch())
+			value, dynamic = genre.ResolvedValue()
+			require.NotNil(t, value)
+			assert.False(t, dynamic)
+			assert.Equal(t, "JAZZ", value.ID)
+
+			// No match at all
+			mood := request.Intent.Slots["mood"]
+			assert.Nil(t, mood.DynamicResolution())
+			value, _ = mood.ResolvedValue()
+			assert.Nil(t, value)
+		},
+	}
+	_, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+}
+
+func TestSlotWithoutResolutions(t *testing.T) {
+	slot := IntentSlot{Name: "slot", Value: "value"}
+	assert.Nil(t, slot.DynamicResolution())
+	assert.Nil(t, slot.StaticResolution())
+	assert.Nil(t, slot.TypedResolutions())
+	value, dynamic := slot.ResolvedValue()
+	assert.Nil(t, value)
+	assert.False(t, dynamic)
+}
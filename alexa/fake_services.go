@@ -0,0 +1,643 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// FakeServiceFactory returns the configured services instead of calling the Alexa APIs. It is used to unit test handlers:
+//
+//	skill.Services = &alexa.FakeServiceFactory{
+//		DeviceAddress: &alexa.FakeDeviceAddressService{ShortAddress: &alexa.DeviceShortAddress{CountryCode: "DE"}},
+//	}
+//
+// If a service is not configured, a fake is returned which fails all calls with a "not configured" error.
+type FakeServiceFactory struct {
+	DeviceAddress   DeviceAddressService
+	Directive       DirectiveService
+	Reminders       RemindersService
+	Lists           ListsService
+	CustomerProfile CustomerProfileService
+	Settings        SettingsService
+	Monetization    MonetizationService
+}
+
+var errServiceNotConfigured = errors.New("The service is not configured in the FakeServiceFactory")
+
+// DeviceAddressService returns the configured device address service.
+func (f *FakeServiceFactory) DeviceAddressService() DeviceAddressService {
+	if f.DeviceAddress == nil {
+		return &FakeDeviceAddressService{Err: errServiceNotConfigured}
+	}
+	return f.DeviceAddress
+}
+
+// DirectiveService returns the configured directive service.
+func (f *FakeServiceFactory) DirectiveService() DirectiveService {
+	if f.Directive == nil {
+		return &FakeDirectiveService{Err: errServiceNotConfigured}
+	}
+	return f.Directive
+}
+
+// RemindersService returns the configured reminders service.
+func (f *FakeServiceFactory) RemindersService() RemindersService {
+	if f.Reminders == nil {
+		return &FakeRemindersService{Err: errServiceNotConfigured}
+	}
+	return f.Reminders
+}
+
+// ListsService returns the configured lists service.
+func (f *FakeServiceFactory) ListsService() ListsService {
+	if f.Lists == nil {
+		return &FakeListsService{Err: errServiceNotConfigured}
+	}
+	return f.Lists
+}
+
+// CustomerProfileService returns the configured customer profile service.
+func (f *FakeServiceFactory) CustomerProfileService() CustomerProfileService {
+	if f.CustomerProfile == nil {
+		return &FakeCustomerProfileService{Err: errServiceNotConfigured}
+	}
+	return f.CustomerProfile
+}
+
+// SettingsService returns the configured settings service.
+func (f *FakeServiceFactory) SettingsService() SettingsService {
+	if f.Settings == nil {
+		return &FakeSettingsService{Err: errServiceNotConfigured}
+	}
+	return f.Settings
+}
+
+// MonetizationService returns the configured monetization service.
+func (f *FakeServiceFactory) MonetizationService() MonetizationService {
+	if f.Monetization == nil {
+		return &FakeMonetizationService{Err: errServiceNotConfigured}
+	}
+	return f.Monetization
+}
+
+// FakeDeviceAddressService answers with the configured addresses.
+type FakeDeviceAddressService struct {
+	ShortAddress *DeviceShortAddress
+	Address      *DeviceAddress
+	// NotAuthorized simulates a customer who did not grant the address permission
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+	// Calls counts the requests to the service
+	Calls int
+}
+
+func (f *FakeDeviceAddressService) fakeError() error {
+	f.Calls++
+	if f.NotAuthorized {
+		return errorForbidden
+	}
+	return f.Err
+}
+
+// GetCountryAndPostalCode returns the configured short address.
+func (f *FakeDeviceAddressService) GetCountryAndPostalCode(system *System) (*DeviceShortAddress, error) {
+	return f.GetCountryAndPostalCodeWithContext(context.Background(), system)
+}
+
+// GetCountryAndPostalCodeWithContext returns the configured short address.
+func (f *FakeDeviceAddressService) GetCountryAndPostalCodeWithContext(ctx context.Context, system *System) (*DeviceShortAddress, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	return f.ShortAddress, nil
+}
+
+// GetFullAddress returns the configured address.
+func (f *FakeDeviceAddressService) GetFullAddress(system *System) (*DeviceAddress, error) {
+	return f.GetFullAddressWithContext(context.Background(), system)
+}
+
+// GetFullAddressWithContext returns the configured address.
+func (f *FakeDeviceAddressService) GetFullAddressWithContext(ctx context.Context, system *System) (*DeviceAddress, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	return f.Address, nil
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeDeviceAddressService) IsNotAuthorizedError(err error) bool {
+	return deviceAddressServiceInstance.IsNotAuthorizedError(err)
+}
+
+// FakeSettingsService answers with the configured device settings.
+type FakeSettingsService struct {
+	TimeZone        string
+	DistanceUnits   string
+	TemperatureUnit string
+	// NotAuthorized simulates a customer who did not grant the settings permission
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+	// Calls counts the requests to the service
+	Calls int
+}
+
+func (f *FakeSettingsService) fakeValue(value string) (string, error) {
+	f.Calls++
+	if f.NotAuthorized {
+		return "", errorForbidden
+	}
+	if f.Err != nil {
+		return "", f.Err
+	}
+	return value, nil
+}
+
+// GetTimeZone returns the configured time zone.
+func (f *FakeSettingsService) GetTimeZone(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.TimeZone)
+}
+
+// GetDistanceUnits returns the configured distance units.
+func (f *FakeSettingsService) GetDistanceUnits(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.DistanceUnits)
+}
+
+// GetTemperatureUnit returns the configured temperature unit.
+func (f *FakeSettingsService) GetTemperatureUnit(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.TemperatureUnit)
+}
+
+// GetLocalTime returns the current time in the configured time zone.
+func (f *FakeSettingsService) GetLocalTime(ctx context.Context, system *System) (time.Time, error) {
+	timeZone, err := f.fakeValue(f.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().In(location), nil
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeSettingsService) IsNotAuthorizedError(err error) bool {
+	return settingsServiceInstance.IsNotAuthorizedError(err)
+}
+
+// FakeDirectiveService records the progressive responses instead of sending them.
+type FakeDirectiveService struct {
+	// Speeches contains the text of all progressive responses
+	Speeches []string
+	// NotAuthorized simulates a invalid access token
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+}
+
+// SendProgressiveResponse records the speech.
+func (f *FakeDirectiveService) SendProgressiveResponse(request *CommonRequest, speech string) error {
+	if f.NotAuthorized {
+		return errorForbidden
+	}
+	if f.Err != nil {
+		return f.Err
+	}
+	f.Speeches = append(f.Speeches, speech)
+	return nil
+}
+
+// StartProgressiveResponse records the speech and returns a channel with the result.
+func (f *FakeDirectiveService) StartProgressiveResponse(request *CommonRequest, speech string) <-chan error {
+	result := make(chan error, 1)
+	result <- f.SendProgressiveResponse(request, speech)
+	close(result)
+	return result
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeDirectiveService) IsNotAuthorizedError(err error) bool {
+	return directiveServiceInstance.IsNotAuthorizedError(err)
+}
+
+// FakeRemindersService keeps the reminders in memory.
+type FakeRemindersService struct {
+	// Reminders contains the reminders by alert token, it can be filled to simulate existing reminders
+	Reminders map[string]*Reminder
+	// NotAuthorized simulates a customer who did not grant the reminders permission
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+	// Calls counts the requests to the service
+	Calls int
+}
+
+func (f *FakeRemindersService) fakeError() error {
+	f.Calls++
+	if f.NotAuthorized {
+		return errReminderPermissionMissing
+	}
+	return f.Err
+}
+
+func (f *FakeRemindersService) storeReminder(alertToken string, reminder *ReminderRequest) *ReminderResponse {
+	if f.Reminders == nil {
+		f.Reminders = make(map[string]*Reminder)
+	}
+	stored := &Reminder{
+		ReminderResponse: ReminderResponse{
+			AlertToken: alertToken,
+			Status:     "ON",
+			Version:    "1",
+		},
+		Trigger:          reminder.Trigger,
+		AlertInfo:        reminder.AlertInfo,
+		PushNotification: reminder.PushNotification,
+	}
+	if existing, ok := f.Reminders[alertToken]; ok {
+		version, _ := strconv.Atoi(existing.Version)
+		stored.Version = strconv.Itoa(version + 1)
+	}
+	f.Reminders[alertToken] = stored
+	response := stored.ReminderResponse
+	return &response
+}
+
+// CreateReminder stores the reminder with a new alert token.
+func (f *FakeRemindersService) CreateReminder(ctx context.Context, system *System, reminder *ReminderRequest) (*ReminderResponse, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	return f.storeReminder(fmt.Sprintf("fake-alert-token-%d", f.Calls), reminder), nil
+}
+
+// GetReminder returns the stored reminder.
+func (f *FakeRemindersService) GetReminder(ctx context.Context, system *System, alertToken string) (*Reminder, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	reminder, ok := f.Reminders[alertToken]
+	if !ok {
+		return nil, &ServiceError{StatusCode: http.StatusNotFound}
+	}
+	return reminder, nil
+}
+
+// UpdateReminder replaces the stored reminder.
+func (f *FakeRemindersService) UpdateReminder(ctx context.Context, system *System, alertToken string, reminder *ReminderRequest) (*ReminderResponse, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	if _, ok := f.Reminders[alertToken]; !ok {
+		return nil, &ServiceError{StatusCode: http.StatusNotFound}
+	}
+	return f.storeReminder(alertToken, reminder), nil
+}
+
+// DeleteReminder removes the stored reminder.
+func (f *FakeRemindersService) DeleteReminder(ctx context.Context, system *System, alertToken string) error {
+	if err := f.fakeError(); err != nil {
+		return err
+	}
+	delete(f.Reminders, alertToken)
+	return nil
+}
+
+// GetReminders returns all stored reminders ordered by alert token.
+func (f *FakeRemindersService) GetReminders(ctx context.Context, system *System) (*ReminderList, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	tokens := make([]string, 0, len(f.Reminders))
+	for token := range f.Reminders {
+		tokens = append(tokens, token)
+	}
+	sort.Strings(tokens)
+	list := &ReminderList{TotalCount: strconv.Itoa(len(tokens))}
+	for _, token := range tokens {
+		list.Alerts = append(list.Alerts, *f.Reminders[token])
+	}
+	return list, nil
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeRemindersService) IsNotAuthorizedError(err error) bool {
+	return remindersServiceInstance.IsNotAuthorizedError(err)
+}
+
+// IsValidationError return true if the reminder was rejected
+func (f *FakeRemindersService) IsValidationError(err error) bool {
+	return remindersServiceInstance.IsValidationError(err)
+}
+
+// FakeListsService keeps the household lists in memory.
+type FakeListsService struct {
+	// Lists contains the lists by list id, it can be filled to simulate existing lists. The items of all states are kept in the list
+	Lists map[string]*HouseholdList
+	// NotAuthorized simulates a customer who did not grant the lists permissions
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+	// Calls counts the requests to the service
+	Calls int
+}
+
+func (f *FakeListsService) fakeError() error {
+	f.Calls++
+	if f.NotAuthorized {
+		return errorForbidden
+	}
+	return f.Err
+}
+
+func (f *FakeListsService) findItem(listID, itemID string) (*HouseholdList, int, error) {
+	list, ok := f.Lists[listID]
+	if !ok {
+		return nil, 0, &ServiceError{StatusCode: http.StatusNotFound}
+	}
+	for i := range list.Items {
+		if list.Items[i].ID == itemID {
+			return list, i, nil
+		}
+	}
+	return nil, 0, &ServiceError{StatusCode: http.StatusNotFound}
+}
+
+// GetListsMetadata returns the metadata of the stored lists ordered by list id.
+func (f *FakeListsService) GetListsMetadata(ctx context.Context, system *System) (*HouseholdListsMetadata, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(f.Lists))
+	for id := range f.Lists {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	metadata := &HouseholdListsMetadata{Lists: make([]HouseholdListMetadata, 0, len(ids))}
+	for _, id := range ids {
+		list := f.Lists[id]
+		metadata.Lists = append(metadata.Lists, HouseholdListMetadata{ListID: id, Name: list.Name, State: list.State, Version: list.Version})
+	}
+	return metadata, nil
+}
+
+// GetList returns the stored list with the items of the given status. The next token is ignored.
+func (f *FakeListsService) GetList(ctx context.Context, system *System, listID, status, nextToken string) (*HouseholdList, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	list, ok := f.Lists[listID]
+	if !ok {
+		return nil, &ServiceError{StatusCode: http.StatusNotFound}
+	}
+	filtered := *list
+	filtered.Items = make([]HouseholdListItem, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Status == status {
+			filtered.Items = append(filtered.Items, item)
+		}
+	}
+	return &filtered, nil
+}
+
+// CreateList stores a new active list.
+func (f *FakeListsService) CreateList(ctx context.Context, system *System, name string) (*HouseholdListMetadata, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	if f.Lists == nil {
+		f.Lists = make(map[string]*HouseholdList)
+	}
+	list := &HouseholdList{
+		ListID:  fmt.Sprintf("fake-list-%d", f.Calls),
+		Name:    name,
+		State:   "active",
+		Version: 1,
+	}
+	f.Lists[list.ListID] = list
+	return &HouseholdListMetadata{ListID: list.ListID, Name: list.Name, State: list.State, Version: list.Version}, nil
+}
+
+// UpdateList changes the name and state of the stored list.
+func (f *FakeListsService) UpdateList(ctx context.Context, system *System, list *HouseholdListMetadata) (*HouseholdListMetadata, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	stored, ok := f.Lists[list.ListID]
+	if !ok {
+		return nil, &ServiceError{StatusCode: http.StatusNotFound}
+	}
+	stored.Name = list.Name
+	stored.State = list.State
+	stored.Version++
+	return &HouseholdListMetadata{ListID: stored.ListID, Name: stored.Name, State: stored.State, Version: stored.Version}, nil
+}
+
+// DeleteList removes the stored list.
+func (f *FakeListsService) DeleteList(ctx context.Context, system *System, listID string) error {
+	if err := f.fakeError(); err != nil {
+		return err
+	}
+	delete(f.Lists, listID)
+	return nil
+}
+
+// GetListItem returns a item of the stored list.
+func (f *FakeListsService) GetListItem(ctx context.Context, system *System, listID, itemID string) (*HouseholdListItem, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	list, i, err := f.findItem(listID, itemID)
+	if err != nil {
+		return nil, err
+	}
+	item := list.Items[i]
+	return &item, nil
+}
+
+// CreateListItem adds a item to the stored list.
+func (f *FakeListsService) CreateListItem(ctx context.Context, system *System, listID, value, status string) (*HouseholdListItem, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	list, ok := f.Lists[listID]
+	if !ok {
+		return nil, &ServiceError{StatusCode: http.StatusNotFound}
+	}
+	item := HouseholdListItem{
+		ID:      fmt.Sprintf("fake-item-%d", f.Calls),
+		Version: 1,
+		Value:   value,
+		Status:  status,
+	}
+	list.Items = append(list.Items, item)
+	return &item, nil
+}
+
+// UpdateListItem changes the value and status of a item of the stored list.
+func (f *FakeListsService) UpdateListItem(ctx context.Context, system *System, listID string, item *HouseholdListItem) (*HouseholdListItem, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	list, i, err := f.findItem(listID, item.ID)
+	if err != nil {
+		return nil, err
+	}
+	list.Items[i].Value = item.Value
+	list.Items[i].Status = item.Status
+	list.Items[i].Version++
+	updated := list.Items[i]
+	return &updated, nil
+}
+
+// DeleteListItem removes a item of the stored list.
+func (f *FakeListsService) DeleteListItem(ctx context.Context, system *System, listID, itemID string) error {
+	if err := f.fakeError(); err != nil {
+		return err
+	}
+	list, i, err := f.findItem(listID, itemID)
+	if err != nil {
+		return err
+	}
+	list.Items = append(list.Items[:i], list.Items[i+1:]...)
+	return nil
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeListsService) IsNotAuthorizedError(err error) bool {
+	return listsServiceInstance.IsNotAuthorizedError(err)
+}
+
+// FakeCustomerProfileService answers with the configured profile values. A empty value is reported as not set.
+type FakeCustomerProfileService struct {
+	Name            string
+	GivenName       string
+	Email           string
+	MobileNumber    *ProfileMobileNumber
+	PersonGivenName string
+	// NotAuthorized simulates a customer who did not grant the profile permissions
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+	// Calls counts the requests to the service
+	Calls int
+}
+
+func (f *FakeCustomerProfileService) fakeValue(value string) (string, error) {
+	f.Calls++
+	if f.NotAuthorized {
+		return "", errorForbidden
+	}
+	if f.Err != nil {
+		return "", f.Err
+	}
+	if value == "" {
+		return "", errProfileValueNotFound
+	}
+	return value, nil
+}
+
+// GetName returns the configured name.
+func (f *FakeCustomerProfileService) GetName(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.Name)
+}
+
+// GetGivenName returns the configured given name.
+func (f *FakeCustomerProfileService) GetGivenName(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.GivenName)
+}
+
+// GetEmail returns the configured email address.
+func (f *FakeCustomerProfileService) GetEmail(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.Email)
+}
+
+// GetMobileNumber returns the configured mobile number.
+func (f *FakeCustomerProfileService) GetMobileNumber(ctx context.Context, system *System) (*ProfileMobileNumber, error) {
+	phoneNumber := ""
+	if f.MobileNumber != nil {
+		phoneNumber = f.MobileNumber.PhoneNumber
+	}
+	if _, err := f.fakeValue(phoneNumber); err != nil {
+		return nil, err
+	}
+	return f.MobileNumber, nil
+}
+
+// GetPersonGivenName returns the configured given name of the recognized speaker.
+func (f *FakeCustomerProfileService) GetPersonGivenName(ctx context.Context, system *System) (string, error) {
+	return f.fakeValue(f.PersonGivenName)
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeCustomerProfileService) IsNotAuthorizedError(err error) bool {
+	return customerProfileServiceInstance.IsNotAuthorizedError(err)
+}
+
+// IsNotFoundError return true if the value is not configured
+func (f *FakeCustomerProfileService) IsNotFoundError(err error) bool {
+	return customerProfileServiceInstance.IsNotFoundError(err)
+}
+
+// FakeMonetizationService answers with the configured in-skill products.
+type FakeMonetizationService struct {
+	Products []InSkillProduct
+	// NotAuthorized simulates a invalid access token
+	NotAuthorized bool
+	// Err is returned by all methods if it is set
+	Err error
+	// Calls counts the requests to the service
+	Calls int
+}
+
+func (f *FakeMonetizationService) fakeError() error {
+	f.Calls++
+	if f.NotAuthorized {
+		return errorForbidden
+	}
+	return f.Err
+}
+
+// GetInSkillProducts returns the configured products matching the type, purchasable and entitled filters. Paging is not simulated.
+func (f *FakeMonetizationService) GetInSkillProducts(ctx context.Context, system *System, locale string, filter *InSkillProductsFilter) (*InSkillProductsResponse, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	response := &InSkillProductsResponse{InSkillProducts: make([]InSkillProduct, 0, len(f.Products))}
+	for _, product := range f.Products {
+		if filter != nil && (filter.ProductType != "" && filter.ProductType != product.Type ||
+			filter.Purchasable != "" && filter.Purchasable != product.Purchasable ||
+			filter.Entitled != "" && filter.Entitled != product.Entitled) {
+			continue
+		}
+		response.InSkillProducts = append(response.InSkillProducts, product)
+	}
+	return response, nil
+}
+
+// GetInSkillProduct returns the configured product with the given id.
+func (f *FakeMonetizationService) GetInSkillProduct(ctx context.Context, system *System, locale, productID string) (*InSkillProduct, error) {
+	if err := f.fakeError(); err != nil {
+		return nil, err
+	}
+	for i := range f.Products {
+		if f.Products[i].ProductID == productID {
+			product := f.Products[i]
+			return &product, nil
+		}
+	}
+	return nil, &ServiceError{StatusCode: http.StatusNotFound}
+}
+
+// IsNotAuthorizedError return true if it is a not authorized error
+func (f *FakeMonetizationService) IsNotAuthorizedError(err error) bool {
+	return monetizationServiceInstance.IsNotAuthorizedError(err)
+}
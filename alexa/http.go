@@ -52,6 +52,7 @@ func (skill *Skill) GetHTTPSkillHandler() http.Handler {
 				return
 			}
 		}
+		requestEnvelope.ctx = r.Context()
 
 		response, err := requestEnvelope.handleRequest(skill)
 
@@ -1,50 +1,57 @@
 package alexa
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"log"
 )
 
-// LambdaHandler interface which a lambda handler must fulfil.
-type LambdaHandler func(ctx context.Context, event interface{}) (interface{}, error)
+// LambdaHandler interface which a lambda handler must fulfil. The event is the raw request sent by Alexa, so it is decoded only once.
+type LambdaHandler func(ctx context.Context, event json.RawMessage) (*ResponseEnvelope, error)
 
 // GetLambdaSkillHandler provides a handler which can be used in a lambda function.
 func (skill *Skill) GetLambdaSkillHandler() LambdaHandler {
-	return func(ctx context.Context, event interface{}) (interface{}, error) {
-		bodyBytes, _ := json.Marshal(event)
+	return skill.handleLambdaEvent
+}
 
-		var requestEnvelope *RequestEnvelope
-		err := json.NewDecoder(bytes.NewReader(bodyBytes)).Decode(&requestEnvelope)
+// Invoke implements the lambda.Handler interface of the AWS lambda library, so the skill can be started with lambda.StartHandler(&skill).
+func (skill *Skill) Invoke(ctx context.Context, payload []byte) ([]byte, error) {
+	response, err := skill.handleLambdaEvent(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(response)
+}
 
-		if skill.Verbose {
-			log.Println("--> Request: ", string(bodyBytes))
-		}
+func (skill *Skill) handleLambdaEvent(ctx context.Context, event json.RawMessage) (*ResponseEnvelope, error) {
+	if skill.Verbose {
+		log.Println("--> Request: ", string(event))
+	}
 
-		if err != nil {
+	var requestEnvelope RequestEnvelope
+	if err := json.Unmarshal(event, &requestEnvelope); err != nil {
+		return nil, err
+	}
+	if !skill.SkipValidation {
+		if err := requestEnvelope.isRequestValid(skill.ApplicationID); err != nil {
 			return nil, err
 		}
-		if !skill.SkipValidation {
-			if err = requestEnvelope.isRequestValid(skill.ApplicationID); err != nil {
-				return nil, err
-			}
-		}
+	}
+	requestEnvelope.ctx = ctx
+
+	response, err := requestEnvelope.handleRequest(skill)
 
-		response, err := requestEnvelope.handleRequest(skill)
+	if err != nil {
+		return nil, err
+	}
 
+	if skill.Verbose {
+		json, err := json.Marshal(response)
 		if err != nil {
 			return nil, err
 		}
-
-		if skill.Verbose {
-			json, err := json.Marshal(response)
-			if err != nil {
-				return nil, err
-			}
-			log.Println("--> Response: ", string(json))
-		}
-
-		return response, nil
+		log.Println("--> Response: ", string(json))
 	}
+
+	return response, nil
 }
@@ -1,12 +1,15 @@
 package alexa
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
-	"os"
+	"io/ioutil"
 	"testing"
 
+	"github.com/aws/aws-lambda-go/lambda"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestLambdaCall(t *testing.T) {
@@ -20,21 +23,38 @@ func TestLambdaCall(t *testing.T) {
 	}
 	skillHandler := skill.GetLambdaSkillHandler()
 
-	launchRequestReader, err := os.Open("../resources/lambda_launch_request.json")
+	event, err := ioutil.ReadFile("../resources/lambda_launch_request.json")
 	if err != nil {
 		t.Error("Error reading input file", err)
 	}
 
-	var event map[string]interface{}
-	json.NewDecoder(launchRequestReader).Decode(&event)
-
-	result, err := skillHandler(context.TODO(), event)
+	responseEnvelope, err := skillHandler(context.TODO(), event)
 
 	assert.NoError(t, err)
+	assert.Equal(t, &Card{Type: "Simple", Title: "title", Content: "test"}, responseEnvelope.Response.Card)
+}
 
-	// result is a Outgoing response object
-	responseEnvelope := result.(*ResponseEnvelope)
+func TestLambdaInvoke(t *testing.T) {
+	skill := Skill{
+		OnLaunch: func(req *LaunchRequest, res *ResponseEnvelope) {
+			res.Response.SetSimpleCard("title", "test")
+		},
+		SkipValidation: true,
+	}
+	// The skill can be started with lambda.StartHandler
+	var handler lambda.Handler = &skill
+
+	event, err := ioutil.ReadFile("../resources/lambda_launch_request.json")
+	require.NoError(t, err)
+	result, err := handler.Invoke(context.TODO(), event)
+	require.NoError(t, err)
+
+	var responseEnvelope ResponseEnvelope
+	require.NoError(t, json.Unmarshal(result, &responseEnvelope))
 	assert.Equal(t, &Card{Type: "Simple", Title: "title", Content: "test"}, responseEnvelope.Response.Card)
+
+	_, err = handler.Invoke(context.TODO(), []byte("no json"))
+	assert.Error(t, err)
 }
 
 func TestLambdaWrongApplicationId(t *testing.T) {
@@ -45,17 +65,10 @@ func TestLambdaWrongApplicationId(t *testing.T) {
 	}
 	skillHandler := skill.GetLambdaSkillHandler()
 
-	launchRequestReader, err := os.Open("../resources/lambda_launch_request.json")
-	if err != nil {
-		t.Error("Error reading input file", err)
-	}
-
-	var event map[string]interface{}
-	json.NewDecoder(launchRequestReader).Decode(&event)
-
+	event := readLambdaEvent(t)
 	event["context"].(map[string]interface{})["System"].(map[string]interface{})["application"].(map[string]interface{})["applicationId"] = "wrong-app-id"
 
-	_, err = skillHandler(context.TODO(), event)
+	_, err := skillHandler(context.TODO(), marshalLambdaEvent(event))
 
 	assert.Error(t, err)
 	assert.Equal(t, "Request too old to continue (>150s)", err.Error())
@@ -69,18 +82,61 @@ func TestLambdaWrongRequestType(t *testing.T) {
 	}
 	skillHandler := skill.GetLambdaSkillHandler()
 
-	launchRequestReader, err := os.Open("../resources/lambda_launch_request.json")
-	if err != nil {
-		t.Error("Error reading input file", err)
+	event := readLambdaEvent(t)
+	event["request"].(map[string]interface{})["type"] = "wrong-type"
+
+	_, err := skillHandler(context.TODO(), marshalLambdaEvent(event))
+
+	assert.Error(t, err)
+	assert.Equal(t, "Invalid request type: wrong-type", err.Error())
+}
+
+func TestLambdaNullEvent(t *testing.T) {
+	skill := Skill{
+		ApplicationID: "amzn1.echo-sdk-ams.app.000000-d0ed-0000-ad00-000000d00ebe",
 	}
+	var handler lambda.Handler = &skill
+	_, err := handler.Invoke(context.TODO(), []byte("null"))
+	assert.Error(t, err)
 
-	var event map[string]interface{}
-	json.NewDecoder(launchRequestReader).Decode(&event)
+	skill.SkipValidation = true
+	_, err = skill.GetLambdaSkillHandler()(context.TODO(), json.RawMessage("null"))
+	assert.Error(t, err)
+}
 
-	event["request"].(map[string]interface{})["type"] = "wrong-type"
+func TestLambdaKeepsNumberPrecision(t *testing.T) {
+	var input json.RawMessage
+	skill := Skill{
+		SkipValidation: true,
+		TaskHandlers: TaskHandlers{
+			countDownTask: func(req *LaunchRequest, res *ResponseEnvelope) {
+				input = req.Task.Input
+			},
+		},
+	}
+	skillHandler := skill.GetLambdaSkillHandler()
+
+	event, err := ioutil.ReadFile("../resources/launch_request_task.json")
+	require.NoError(t, err)
+	event = bytes.Replace(event, []byte(`"upLimit": 10`), []byte(`"id": 9007199254740993`), 1)
 
 	_, err = skillHandler(context.TODO(), event)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": 9007199254740993, "downLimit": 0}`, string(input))
+	assert.Contains(t, string(input), "9007199254740993")
+}
 
-	assert.Error(t, err)
-	assert.Equal(t, "Invalid request type: wrong-type", err.Error())
+func readLambdaEvent(t *testing.T) map[string]interface{} {
+	launchRequest, err := ioutil.ReadFile("../resources/lambda_launch_request.json")
+	if err != nil {
+		t.Error("Error reading input file", err)
+	}
+	var event map[string]interface{}
+	json.Unmarshal(launchRequest, &event)
+	return event
+}
+
+func marshalLambdaEvent(event map[string]interface{}) json.RawMessage {
+	bytes, _ := json.Marshal(event)
+	return bytes
 }
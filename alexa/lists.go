@@ -0,0 +1,193 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+// ListsService provides methods to read and write the customers household lists like the Alexa shopping and to-do lists.
+// The skill needs the permissions 'read::alexa:household:list' and/or 'write::alexa:household:list'.
+type ListsService interface {
+	// GetListsMetadata gets the metadata of all lists of the customer.
+	GetListsMetadata(ctx context.Context, system *System) (*HouseholdListsMetadata, error)
+	// GetList gets the list with its items filtered by the item status (active or completed).
+	// Use the next token of a previous response to read the next page or a empty string to read the first page.
+	GetList(ctx context.Context, system *System, listID, status, nextToken string) (*HouseholdList, error)
+	// CreateList creates a new list with the given name.
+	CreateList(ctx context.Context, system *System, name string) (*HouseholdListMetadata, error)
+	// UpdateList changes the name or state of the list. The version must match the current version of the list.
+	UpdateList(ctx context.Context, system *System, list *HouseholdListMetadata) (*HouseholdListMetadata, error)
+	// DeleteList deletes the list with the given id.
+	DeleteList(ctx context.Context, system *System, listID string) error
+	// GetListItem gets a single item of a list.
+	GetListItem(ctx context.Context, system *System, listID, itemID string) (*HouseholdListItem, error)
+	// CreateListItem creates a new item with the given value and status.
+	CreateListItem(ctx context.Context, system *System, listID, value, status string) (*HouseholdListItem, error)
+	// UpdateListItem changes the value or status of a item. The version must match the current version of the item.
+	UpdateListItem(ctx context.Context, system *System, listID string, item *HouseholdListItem) (*HouseholdListItem, error)
+	// DeleteListItem deletes a item of a list.
+	DeleteListItem(ctx context.Context, system *System, listID, itemID string) error
+	// IsNotAuthorizedError return true if it is a not authorized error
+	IsNotAuthorizedError(err error) bool
+}
+
+// Status of list items
+const (
+	ListItemStatusActive    = "active"
+	ListItemStatusCompleted = "completed"
+)
+
+// HouseholdListsMetadata contains the metadata of all lists of the customer.
+type HouseholdListsMetadata struct {
+	Lists []HouseholdListMetadata `json:"lists"`
+}
+
+// HouseholdListMetadata describes a list without its items.
+type HouseholdListMetadata struct {
+	ListID string `json:"listId,omitempty"`
+	Name   string `json:"name"`
+	// State is active or archived
+	State   string `json:"state"`
+	Version int    `json:"version,omitempty"`
+	// StatusMap contains the links to the active and completed items of the list
+	StatusMap []struct {
+		Href   string `json:"href"`
+		Status string `json:"status"`
+	} `json:"statusMap,omitempty"`
+}
+
+// HouseholdList is a list with the items of a single status.
+type HouseholdList struct {
+	ListID  string              `json:"listId"`
+	Name    string              `json:"name"`
+	State   string              `json:"state"`
+	Version int                 `json:"version"`
+	Items   []HouseholdListItem `json:"items"`
+	Links   struct {
+		// Next is the link to the next page if the list has more items
+		Next string `json:"next,omitempty"`
+	} `json:"links"`
+}
+
+// HouseholdListItem is a single item of a list.
+type HouseholdListItem struct {
+	ID      string `json:"id,omitempty"`
+	Version int    `json:"version,omitempty"`
+	Value   string `json:"value"`
+	// Status is active or completed
+	Status      string `json:"status"`
+	CreatedTime string `json:"createdTime,omitempty"`
+	UpdatedTime string `json:"updatedTime,omitempty"`
+	Href        string `json:"href,omitempty"`
+}
+
+// NextToken returns the token to read the next page of the list or a empty string if there are no more items.
+func (l *HouseholdList) NextToken() string {
+	if l.Links.Next == "" {
+		return ""
+	}
+	next, err := url.Parse(l.Links.Next)
+	if err != nil {
+		return ""
+	}
+	return next.Query().Get("nextToken")
+}
+
+type listsService struct {
+	client *ServiceClient
+}
+
+var listsServiceInstance = NewListsService(defaultServiceClient)
+
+// NewListsService creates a lists service sending the requests with the given client.
+func NewListsService(client *ServiceClient) ListsService {
+	return &listsService{client: client}
+}
+
+func (s *listsService) executeListsCall(ctx context.Context, system *System, method, path string, body, targetObj interface{}) error {
+	return s.client.Do(ctx, method, system.APIEndpoint, "/v2/householdlists/"+path, system.APIAccessToken, body, targetObj)
+}
+
+func (s *listsService) GetListsMetadata(ctx context.Context, system *System) (*HouseholdListsMetadata, error) {
+	var metadata HouseholdListsMetadata
+	err := s.executeListsCall(ctx, system, http.MethodGet, "", nil, &metadata)
+	return &metadata, err
+}
+
+func (s *listsService) GetList(ctx context.Context, system *System, listID, status, nextToken string) (*HouseholdList, error) {
+	path := url.PathEscape(listID) + "/" + url.PathEscape(status)
+	if nextToken != "" {
+		path += "?nextToken=" + url.QueryEscape(nextToken)
+	}
+	var list HouseholdList
+	err := s.executeListsCall(ctx, system, http.MethodGet, path, nil, &list)
+	return &list, err
+}
+
+func (s *listsService) CreateList(ctx context.Context, system *System, name string) (*HouseholdListMetadata, error) {
+	var list HouseholdListMetadata
+	err := s.executeListsCall(ctx, system, http.MethodPost, "", &HouseholdListMetadata{Name: name, State: "active"}, &list)
+	return &list, err
+}
+
+func (s *listsService) UpdateList(ctx context.Context, system *System, list *HouseholdListMetadata) (*HouseholdListMetadata, error) {
+	var updated HouseholdListMetadata
+	body := &HouseholdListMetadata{
+		Name:    list.Name,
+		State:   list.State,
+		Version: list.Version,
+	}
+	err := s.executeListsCall(ctx, system, http.MethodPut, url.PathEscape(list.ListID), body, &updated)
+	return &updated, err
+}
+
+func (s *listsService) DeleteList(ctx context.Context, system *System, listID string) error {
+	return s.executeListsCall(ctx, system, http.MethodDelete, url.PathEscape(listID), nil, nil)
+}
+
+func (s *listsService) GetListItem(ctx context.Context, system *System, listID, itemID string) (*HouseholdListItem, error) {
+	var item HouseholdListItem
+	err := s.executeListsCall(ctx, system, http.MethodGet, url.PathEscape(listID)+"/items/"+url.PathEscape(itemID), nil, &item)
+	return &item, err
+}
+
+func (s *listsService) CreateListItem(ctx context.Context, system *System, listID, value, status string) (*HouseholdListItem, error) {
+	var item HouseholdListItem
+	err := s.executeListsCall(ctx, system, http.MethodPost, url.PathEscape(listID)+"/items", &HouseholdListItem{Value: value, Status: status}, &item)
+	return &item, err
+}
+
+func (s *listsService) UpdateListItem(ctx context.Context, system *System, listID string, item *HouseholdListItem) (*HouseholdListItem, error) {
+	var updated HouseholdListItem
+	body := &HouseholdListItem{
+		Value:   item.Value,
+		Status:  item.Status,
+		Version: item.Version,
+	}
+	err := s.executeListsCall(ctx, system, http.MethodPut, url.PathEscape(listID)+"/items/"+url.PathEscape(item.ID), body, &updated)
+	return &updated, err
+}
+
+func (s *listsService) DeleteListItem(ctx context.Context, system *System, listID, itemID string) error {
+	return s.executeListsCall(ctx, system, http.MethodDelete, url.PathEscape(listID)+"/items/"+url.PathEscape(itemID), nil, nil)
+}
+
+func (s *listsService) IsNotAuthorizedError(err error) bool {
+	return errors.Is(err, errorForbidden)
+}
+
+// ListEventRequest is send if the customer created, updated or deleted a list or list items.
+// Types are AlexaHouseholdListEvent.ListCreated, ListUpdated, ListDeleted, ItemsCreated, ItemsUpdated and ItemsDeleted.
+// The skill must be subscribed to the events in the skill manifest. The request has no session and the response is ignored.
+type ListEventRequest struct {
+	CommonRequest
+	EventCreationTime   string `json:"eventCreationTime"`
+	EventPublishingTime string `json:"eventPublishingTime"`
+	Body                struct {
+		ListID string `json:"listId"`
+		// ListItemIDs is set for the Items* events
+		ListItemIDs []string `json:"listItemIds,omitempty"`
+	} `json:"body"`
+}
@@ -0,0 +1,199 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newListsTestServer() *httptest.Server {
+	items := []HouseholdListItem{
+		{ID: "item-1", Version: 1, Value: "Milk", Status: ListItemStatusActive},
+		{ID: "item-2", Version: 1, Value: "Bread", Status: ListItemStatusActive},
+		{ID: "item-3", Version: 1, Value: "Eggs", Status: ListItemStatusCompleted},
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tokenOk" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		path := strings.Split(strings.TrimPrefix(r.URL.Path, "/v2/householdlists/"), "/")
+		switch {
+		case r.Method == http.MethodGet && path[0] == "":
+			json.NewEncoder(w).Encode(HouseholdListsMetadata{Lists: []HouseholdListMetadata{
+				{ListID: "shopping", Name: "Alexa shopping list", State: "active", Version: 1},
+				{ListID: "todo", Name: "Alexa to-do list", State: "active", Version: 1},
+			}})
+		case r.Method == http.MethodPost && path[0] == "":
+			var list HouseholdListMetadata
+			json.NewDecoder(r.Body).Decode(&list)
+			list.ListID = "new-list"
+			list.Version = 1
+			json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodPut && len(path) == 1:
+			var list HouseholdListMetadata
+			json.NewDecoder(r.Body).Decode(&list)
+			list.ListID = path[0]
+			list.Version++
+			json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodGet && len(path) == 2 && path[1] != "items":
+			// Page size of one item to test the pagination
+			list := HouseholdList{ListID: path[0], Name: "Alexa shopping list", State: "active", Version: 1}
+			var filtered []HouseholdListItem
+			for _, item := range items {
+				if item.Status == path[1] {
+					filtered = append(filtered, item)
+				}
+			}
+			page := 0
+			if r.URL.Query().Get("nextToken") == "page-2" {
+				page = 1
+			}
+			if page < len(filtered) {
+				list.Items = filtered[page : page+1]
+			}
+			if page+1 < len(filtered) {
+				list.Links.Next = "v2/householdlists/" + path[0] + "/" + path[1] + "?nextToken=page-2"
+			}
+			json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodGet && len(path) == 3:
+			for _, item := range items {
+				if item.ID == path[2] {
+					json.NewEncoder(w).Encode(item)
+					return
+				}
+			}
+			http.Error(w, "Not found", http.StatusNotFound)
+		case r.Method == http.MethodPost && len(path) == 2:
+			var item HouseholdListItem
+			json.NewDecoder(r.Body).Decode(&item)
+			item.ID = "item-4"
+			item.Version = 1
+			json.NewEncoder(w).Encode(item)
+		case r.Method == http.MethodPut && len(path) == 3:
+			var item HouseholdListItem
+			json.NewDecoder(r.Body).Decode(&item)
+			item.ID = path[2]
+			item.Version++
+			json.NewEncoder(w).Encode(item)
+		case r.Method == http.MethodDelete:
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.Error(w, "Bad request", http.StatusBadRequest)
+		}
+	}))
+}
+
+func TestListsService(t *testing.T) {
+	ts := newListsTestServer()
+	defer ts.Close()
+
+	listsService := GetListsService()
+	system := &System{
+		APIAccessToken: "tokenOk",
+		APIEndpoint:    ts.URL,
+	}
+
+	metadata, err := listsService.GetListsMetadata(context.Background(), system)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(metadata.Lists))
+	assert.Equal(t, "Alexa to-do list", metadata.Lists[1].Name)
+
+	list, err := listsService.GetList(context.Background(), system, "shopping", ListItemStatusActive, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Items))
+	assert.Equal(t, "Milk", list.Items[0].Value)
+	assert.Equal(t, "page-2", list.NextToken())
+
+	list, err = listsService.GetList(context.Background(), system, "shopping", ListItemStatusActive, list.NextToken())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Items))
+	assert.Equal(t, "Bread", list.Items[0].Value)
+	assert.Equal(t, "", list.NextToken())
+
+	list, err = listsService.GetList(context.Background(), system, "shopping", ListItemStatusCompleted, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Items))
+	assert.Equal(t, "Eggs", list.Items[0].Value)
+
+	created, err := listsService.CreateList(context.Background(), system, "Party")
+	require.NoError(t, err)
+	assert.Equal(t, "new-list", created.ListID)
+	assert.Equal(t, "Party", created.Name)
+	assert.Equal(t, "active", created.State)
+
+	created.State = "archived"
+	updated, err := listsService.UpdateList(context.Background(), system, created)
+	require.NoError(t, err)
+	assert.Equal(t, "archived", updated.State)
+	assert.Equal(t, 2, updated.Version)
+	assert.NoError(t, listsService.DeleteList(context.Background(), system, created.ListID))
+
+	item, err := listsService.GetListItem(context.Background(), system, "shopping", "item-2")
+	require.NoError(t, err)
+	assert.Equal(t, "Bread", item.Value)
+
+	newItem, err := listsService.CreateListItem(context.Background(), system, "shopping", "Butter", ListItemStatusActive)
+	require.NoError(t, err)
+	assert.Equal(t, "item-4", newItem.ID)
+	assert.Equal(t, "Butter", newItem.Value)
+
+	newItem.Status = ListItemStatusCompleted
+	updatedItem, err := listsService.UpdateListItem(context.Background(), system, "shopping", newItem)
+	require.NoError(t, err)
+	assert.Equal(t, ListItemStatusCompleted, updatedItem.Status)
+	assert.Equal(t, 2, updatedItem.Version)
+	assert.NoError(t, listsService.DeleteListItem(context.Background(), system, "shopping", newItem.ID))
+
+	_, err = listsService.GetListItem(context.Background(), system, "shopping", "unknown")
+	assert.Error(t, err)
+	assert.False(t, listsService.IsNotAuthorizedError(err))
+}
+
+func TestListsServiceNotAuthorized(t *testing.T) {
+	ts := newListsTestServer()
+	defer ts.Close()
+
+	system := &System{
+		APIAccessToken: "tokenNotOk",
+		APIEndpoint:    ts.URL,
+	}
+	_, err := GetListsService().GetListsMetadata(context.Background(), system)
+	require.Error(t, err)
+	assert.True(t, GetListsService().IsNotAuthorizedError(err))
+}
+
+func TestListEvent(t *testing.T) {
+	eventRequest, _ := ioutil.ReadFile("../resources/list_event_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(eventRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnListEvent: func(request *ListEventRequest, response *ResponseEnvelope) {
+			called = true
+			assert.Equal(t, "AlexaHouseholdListEvent.ItemsCreated", request.Type)
+			assert.Equal(t, "2015-05-13T12:34:50Z", request.EventCreationTime)
+			assert.Equal(t, "YW16bjEuYWNjb3VudC5BRVNJRE5FT0pVRk1BMkxSRTQzS1FDNllYUkRLQS1TSE9QUElOR19JVEVN", request.Body.ListID)
+			assert.Equal(t, []string{"item-1", "item-2"}, request.Body.ListItemIDs)
+			assert.Equal(t, "https://api.amazonalexa.com", request.Context.System.APIEndpoint)
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Nil(t, response.Response)
+
+	// Events without handler are accepted
+	skill.OnListEvent = nil
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Nil(t, response.Response)
+}
@@ -0,0 +1,142 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LWA scopes of the Alexa APIs called outside of a skill session
+const (
+	LWAScopeSkillMessaging  = "alexa:skill_messaging"
+	LWAScopeProactiveEvents = "alexa::proactive_events"
+	LWAScopeReminders       = "alexa::alerts:reminders:skill:readwrite"
+)
+
+// lwaExpiryMargin renews tokens shortly before they expire, so a token does not expire while a request is sent.
+const lwaExpiryMargin = time.Minute
+
+// LWAClient fetches access tokens from Login with Amazon (LWA) for the Alexa APIs called outside of a skill session,
+// e.g. skill messaging and proactive events. The tokens are cached per scope until they expire.
+// The client is safe for concurrent use.
+type LWAClient struct {
+	// ClientID and ClientSecret of the skill, see the permissions section of the skill in the developer console
+	ClientID     string
+	ClientSecret string
+	// TokenURL is the LWA token endpoint. https://api.amazon.com/auth/o2/token is used if it is empty
+	TokenURL string
+	// HTTPClient sends the requests. A client with a timeout of 10 seconds is used if it is nil
+	HTTPClient *http.Client
+
+	mutex  sync.Mutex
+	tokens map[string]*lwaToken
+	// now returns the current time and is replaced in tests
+	now func() time.Time
+}
+
+// lwaToken is the cached token of a scope. The mutex serializes the refresh of the token.
+type lwaToken struct {
+	mutex       sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+type lwaTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	ExpiresIn   int    `json:"expires_in"`
+	Scope       string `json:"scope"`
+	TokenType   string `json:"token_type"`
+}
+
+// NewLWAClient creates a LWA client with the credentials of the skill.
+func NewLWAClient(clientID, clientSecret string) *LWAClient {
+	return &LWAClient{
+		ClientID:     clientID,
+		ClientSecret: clientSecret,
+	}
+}
+
+// GetAccessToken returns a valid access token for the given scope. A cached token is returned if it is not about to expire,
+// otherwise a new token is requested. Concurrent calls for the same scope request the token only once.
+func (c *LWAClient) GetAccessToken(ctx context.Context, scope string) (string, error) {
+	c.mutex.Lock()
+	if c.tokens == nil {
+		c.tokens = make(map[string]*lwaToken)
+	}
+	token, ok := c.tokens[scope]
+	if !ok {
+		token = &lwaToken{}
+		c.tokens[scope] = token
+	}
+	c.mutex.Unlock()
+
+	token.mutex.Lock()
+	defer token.mutex.Unlock()
+	now := c.currentTime()
+	if token.accessToken != "" && now.Before(token.expiry.Add(-lwaExpiryMargin)) {
+		return token.accessToken, nil
+	}
+	response, err := c.requestToken(ctx, scope)
+	if err != nil {
+		return "", err
+	}
+	token.accessToken = response.AccessToken
+	token.expiry = now.Add(time.Duration(response.ExpiresIn) * time.Second)
+	return token.accessToken, nil
+}
+
+func (c *LWAClient) currentTime() time.Time {
+	if c.now == nil {
+		return time.Now()
+	}
+	return c.now()
+}
+
+func (c *LWAClient) requestToken(ctx context.Context, scope string) (*lwaTokenResponse, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", c.ClientID)
+	form.Set("client_secret", c.ClientSecret)
+	form.Set("scope", scope)
+
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = "https://api.amazon.com/auth/o2/token"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &ServiceError{
+			StatusCode: resp.StatusCode,
+			RequestID:  resp.Header.Get("X-Amzn-RequestId"),
+			Body:       respBytes,
+		}
+	}
+	var response lwaTokenResponse
+	err = json.Unmarshal(respBytes, &response)
+	return &response, err
+}
@@ -0,0 +1,117 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLWATestServer(calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(calls, 1)
+		r.ParseForm()
+		if r.PostForm.Get("grant_type") != "client_credentials" || r.PostForm.Get("client_secret") != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"invalid_client","error_description":"Client authentication failed"}`))
+			return
+		}
+		// Slow token endpoint to test concurrent requests
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprintf(w, `{"access_token":"%s-%d","expires_in":3600,"scope":"%s","token_type":"bearer"}`,
+			r.PostForm.Get("scope"), count, r.PostForm.Get("scope"))
+	}))
+}
+
+// newLWAScopeTestServer issues the access token 'token-<scope>' for every requested scope.
+// The services using LWA tokens check the scope with it.
+func newLWAScopeTestServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		fmt.Fprintf(w, `{"access_token":"token-%s","expires_in":3600}`, r.PostForm.Get("scope"))
+	}))
+}
+
+func TestLWAClient(t *testing.T) {
+	var calls int32
+	ts := newLWATestServer(&calls)
+	defer ts.Close()
+
+	now := time.Now()
+	client := NewLWAClient("clientId", "secret")
+	client.TokenURL = ts.URL
+	client.now = func() time.Time { return now }
+
+	token, err := client.GetAccessToken(context.Background(), LWAScopeSkillMessaging)
+	require.NoError(t, err)
+	assert.Equal(t, "alexa:skill_messaging-1", token)
+
+	// Cached token
+	token, err = client.GetAccessToken(context.Background(), LWAScopeSkillMessaging)
+	require.NoError(t, err)
+	assert.Equal(t, "alexa:skill_messaging-1", token)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	// Tokens are cached per scope
+	token, err = client.GetAccessToken(context.Background(), LWAScopeProactiveEvents)
+	require.NoError(t, err)
+	assert.Equal(t, "alexa::proactive_events-2", token)
+
+	// Token is renewed before it expires
+	now = now.Add(59*time.Minute + time.Second)
+	token, err = client.GetAccessToken(context.Background(), LWAScopeSkillMessaging)
+	require.NoError(t, err)
+	assert.Equal(t, "alexa:skill_messaging-3", token)
+}
+
+func TestLWAClientConcurrentRefresh(t *testing.T) {
+	var calls int32
+	ts := newLWATestServer(&calls)
+	defer ts.Close()
+
+	client := NewLWAClient("clientId", "secret")
+	client.TokenURL = ts.URL
+
+	var wg sync.WaitGroup
+	tokens := make([]string, 10)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], _ = client.GetAccessToken(context.Background(), LWAScopeSkillMessaging)
+		}(i)
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	for _, token := range tokens {
+		assert.Equal(t, "alexa:skill_messaging-1", token)
+	}
+}
+
+func TestLWAClientInvalidCredentials(t *testing.T) {
+	var calls int32
+	ts := newLWATestServer(&calls)
+	defer ts.Close()
+
+	client := NewLWAClient("clientId", "wrong secret")
+	client.TokenURL = ts.URL
+
+	_, err := client.GetAccessToken(context.Background(), LWAScopeSkillMessaging)
+	var serviceErr *ServiceError
+	require.True(t, errors.As(err, &serviceErr))
+	assert.Equal(t, http.StatusUnauthorized, serviceErr.StatusCode)
+	assert.Contains(t, string(serviceErr.Body), "invalid_client")
+
+	// Failed requests are not cached
+	_, err = client.GetAccessToken(context.Background(), LWAScopeSkillMessaging)
+	assert.Error(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
@@ -0,0 +1,135 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// MonetizationService provides methods to read the in-skill products of the skill and the entitlements of the customer.
+type MonetizationService interface {
+	// GetInSkillProducts gets the in-skill products in the language of the locale. The filter is optional.
+	GetInSkillProducts(ctx context.Context, system *System, locale string, filter *InSkillProductsFilter) (*InSkillProductsResponse, error)
+	// GetInSkillProduct gets a single in-skill product in the language of the locale.
+	GetInSkillProduct(ctx context.Context, system *System, locale, productID string) (*InSkillProduct, error)
+	// IsNotAuthorizedError return true if it is a not authorized error
+	IsNotAuthorizedError(err error) bool
+}
+
+// In-skill product types
+const (
+	InSkillProductTypeSubscription = "SUBSCRIPTION"
+	InSkillProductTypeEntitlement  = "ENTITLEMENT"
+	InSkillProductTypeConsumable   = "CONSUMABLE"
+)
+
+// Entitlement and purchasable states of in-skill products
+const (
+	InSkillProductEntitled       = "ENTITLED"
+	InSkillProductNotEntitled    = "NOT_ENTITLED"
+	InSkillProductPurchasable    = "PURCHASABLE"
+	InSkillProductNotPurchasable = "NOT_PURCHASABLE"
+)
+
+// InSkillProductsFilter restricts the in-skill products returned by the monetization service. Empty fields are ignored.
+type InSkillProductsFilter struct {
+	// ProductType is SUBSCRIPTION, ENTITLEMENT or CONSUMABLE
+	ProductType string
+	// Purchasable is PURCHASABLE or NOT_PURCHASABLE
+	Purchasable string
+	// Entitled is ENTITLED or NOT_ENTITLED
+	Entitled   string
+	NextToken  string
+	MaxResults int
+}
+
+// InSkillProductsResponse contains a page of in-skill products.
+type InSkillProductsResponse struct {
+	InSkillProducts []InSkillProduct `json:"inSkillProducts"`
+	IsTruncated     bool             `json:"isTruncated"`
+	// NextToken is set if there are more products
+	NextToken string `json:"nextToken,omitempty"`
+}
+
+// InSkillProduct is a product the customer can buy in the skill.
+type InSkillProduct struct {
+	ProductID     string `json:"productId"`
+	ReferenceName string `json:"referenceName"`
+	Type          string `json:"type"`
+	Name          string `json:"name"`
+	Summary       string `json:"summary"`
+	Entitled      string `json:"entitled"`
+	// EntitlementReason is PURCHASED, NOT_PURCHASED or AUTO_ENTITLED
+	EntitlementReason      string `json:"entitlementReason"`
+	Purchasable            string `json:"purchasable"`
+	ActiveEntitlementCount int    `json:"activeEntitlementCount"`
+	// PurchaseMode is TEST or LIVE
+	PurchaseMode string `json:"purchaseMode"`
+}
+
+// IsEntitled returns true if the customer owns the product.
+func (p *InSkillProduct) IsEntitled() bool {
+	return p.Entitled == InSkillProductEntitled
+}
+
+// IsPurchasable returns true if the customer can buy the product.
+func (p *InSkillProduct) IsPurchasable() bool {
+	return p.Purchasable == InSkillProductPurchasable
+}
+
+type monetizationService struct {
+	client *ServiceClient
+}
+
+var monetizationServiceInstance = NewMonetizationService(defaultServiceClient)
+
+// NewMonetizationService creates a monetization service sending the requests with the given client.
+func NewMonetizationService(client *ServiceClient) MonetizationService {
+	return &monetizationService{client: client}
+}
+
+func (s *monetizationService) executeMonetizationCall(ctx context.Context, system *System, locale, path string, targetObj interface{}) error {
+	header := http.Header{}
+	header.Set("Accept-Language", locale)
+	return s.client.DoWithHeader(ctx, http.MethodGet, system.APIEndpoint, "/v1/users/~current/skills/~current/inSkillProducts"+path, system.APIAccessToken, header, nil, targetObj)
+}
+
+func (s *monetizationService) GetInSkillProducts(ctx context.Context, system *System, locale string, filter *InSkillProductsFilter) (*InSkillProductsResponse, error) {
+	query := url.Values{}
+	if filter != nil {
+		if filter.ProductType != "" {
+			query.Set("productType", filter.ProductType)
+		}
+		if filter.Purchasable != "" {
+			query.Set("purchasable", filter.Purchasable)
+		}
+		if filter.Entitled != "" {
+			query.Set("entitled", filter.Entitled)
+		}
+		if filter.NextToken != "" {
+			query.Set("nextToken", filter.NextToken)
+		}
+		if filter.MaxResults > 0 {
+			query.Set("maxResults", strconv.Itoa(filter.MaxResults))
+		}
+	}
+	path := ""
+	if len(query) > 0 {
+		path = "?" + query.Encode()
+	}
+	var products InSkillProductsResponse
+	err := s.executeMonetizationCall(ctx, system, locale, path, &products)
+	return &products, err
+}
+
+func (s *monetizationService) GetInSkillProduct(ctx context.Context, system *System, locale, productID string) (*InSkillProduct, error) {
+	var product InSkillProduct
+	err := s.executeMonetizationCall(ctx, system, locale, "/"+url.PathEscape(productID), &product)
+	return &product, err
+}
+
+func (s *monetizationService) IsNotAuthorizedError(err error) bool {
+	return errors.Is(err, errorForbidden)
+}
@@ -0,0 +1,68 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMonetizationService(t *testing.T) {
+	products := []InSkillProduct{
+		{ProductID: "product-1", ReferenceName: "premium", Type: InSkillProductTypeSubscription, Name: "Premium",
+			Entitled: InSkillProductEntitled, Purchasable: InSkillProductNotPurchasable, ActiveEntitlementCount: 1},
+		{ProductID: "product-2", ReferenceName: "hints", Type: InSkillProductTypeConsumable, Name: "Hints",
+			Entitled: InSkillProductNotEntitled, Purchasable: InSkillProductPurchasable},
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tokenOk" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		assert.Equal(t, "en-US", r.Header.Get("Accept-Language"))
+		switch r.URL.Path {
+		case "/v1/users/~current/skills/~current/inSkillProducts":
+			var response InSkillProductsResponse
+			for _, product := range products {
+				if r.URL.Query().Get("purchasable") == "" || r.URL.Query().Get("purchasable") == product.Purchasable {
+					response.InSkillProducts = append(response.InSkillProducts, product)
+				}
+			}
+			json.NewEncoder(w).Encode(response)
+		case "/v1/users/~current/skills/~current/inSkillProducts/product-1":
+			json.NewEncoder(w).Encode(products[0])
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	monetizationService := GetMonetizationService()
+	system := &System{
+		APIAccessToken: "tokenOk",
+		APIEndpoint:    ts.URL,
+	}
+
+	all, err := monetizationService.GetInSkillProducts(context.Background(), system, "en-US", nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(all.InSkillProducts))
+	assert.True(t, all.InSkillProducts[0].IsEntitled())
+	assert.False(t, all.InSkillProducts[0].IsPurchasable())
+
+	purchasable, err := monetizationService.GetInSkillProducts(context.Background(), system, "en-US", &InSkillProductsFilter{Purchasable: InSkillProductPurchasable})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(purchasable.InSkillProducts))
+	assert.Equal(t, "hints", purchasable.InSkillProducts[0].ReferenceName)
+
+	product, err := monetizationService.GetInSkillProduct(context.Background(), system, "en-US", "product-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Premium", product.Name)
+
+	system.APIAccessToken = "tokenNotOk"
+	_, err = monetizationService.GetInSkillProduct(context.Background(), system, "en-US", "product-1")
+	assert.True(t, monetizationService.IsNotAuthorizedError(err))
+}
@@ -0,0 +1,238 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ProactiveEventsService sends proactive events to the customers of the skill, e.g. to notify them about a new message or a shipped order.
+// The events must be declared in the skill manifest and are authorized with a LWA token for the scope 'alexa::proactive_events'.
+type ProactiveEventsService interface {
+	// SendEvent sends the event to the audience of the event.
+	SendEvent(ctx context.Context, event *ProactiveEvent) error
+}
+
+// Stages of the proactive events API. Events sent to the development stage are only delivered to the developers of the skill.
+const (
+	ProactiveEventsStageDevelopment = "development"
+	ProactiveEventsStageLive        = "live"
+)
+
+// API endpoints of the Alexa regions for calls outside of a skill session
+const (
+	APIEndpointNorthAmerica = "https://api.amazonalexa.com"
+	APIEndpointEurope       = "https://api.eu.amazonalexa.com"
+	APIEndpointFarEast      = "https://api.fe.amazonalexa.com"
+)
+
+// Expiry limits of proactive events
+const (
+	ProactiveEventMinExpiry = 5 * time.Minute
+	ProactiveEventMaxExpiry = 24 * time.Hour
+)
+
+var errInvalidProactiveEventExpiry = errors.New("The expiry time of a proactive event must be between 5 minutes and 24 hours after the timestamp")
+
+// ProactiveEventSchema is the payload of a proactive event, e.g. a MessageAlertEvent.
+type ProactiveEventSchema interface {
+	// EventName is the name of the schema, e.g. 'AMAZON.MessageAlert.Activated'
+	EventName() string
+}
+
+// ProactiveEvent is sent to the proactive events API.
+type ProactiveEvent struct {
+	Timestamp time.Time `json:"timestamp"`
+	// ReferenceID identifies the event. Events with the same reference id replace each other
+	ReferenceID string    `json:"referenceId"`
+	ExpiryTime  time.Time `json:"expiryTime"`
+	Event       struct {
+		Name    string               `json:"name"`
+		Payload ProactiveEventSchema `json:"payload"`
+	} `json:"event"`
+	// LocalizedAttributes contain the values for 'localizedattribute:' references in the payload per locale
+	LocalizedAttributes []map[string]string    `json:"localizedAttributes"`
+	RelevantAudience    ProactiveEventAudience `json:"relevantAudience"`
+}
+
+// ProactiveEventAudience defines who receives a proactive event.
+type ProactiveEventAudience struct {
+	// Type is Unicast for a single customer or Multicast for all subscribed customers
+	Type    string `json:"type"`
+	Payload struct {
+		User string `json:"user,omitempty"`
+	} `json:"payload"`
+}
+
+// NewProactiveEvent creates a event which expires after the given duration. The audience is Multicast until SetUnicast is called.
+func NewProactiveEvent(referenceID string, payload ProactiveEventSchema, expiry time.Duration) *ProactiveEvent {
+	now := time.Now().UTC()
+	event := &ProactiveEvent{
+		Timestamp:           now,
+		ReferenceID:         referenceID,
+		ExpiryTime:          now.Add(expiry),
+		LocalizedAttributes: make([]map[string]string, 0),
+	}
+	event.Event.Name = payload.EventName()
+	event.Event.Payload = payload
+	event.RelevantAudience.Type = "Multicast"
+	return event
+}
+
+// SetUnicast sends the event only to the customer with the given user id.
+func (e *ProactiveEvent) SetUnicast(userID string) *ProactiveEvent {
+	e.RelevantAudience.Type = "Unicast"
+	e.RelevantAudience.Payload.User = userID
+	return e
+}
+
+// SetMulticast sends the event to all customers subscribed to the event.
+func (e *ProactiveEvent) SetMulticast() *ProactiveEvent {
+	e.RelevantAudience.Type = "Multicast"
+	e.RelevantAudience.Payload.User = ""
+	return e
+}
+
+// AddLocalizedAttributes adds the values of the localized attributes for the given locale.
+func (e *ProactiveEvent) AddLocalizedAttributes(locale string, attributes map[string]string) *ProactiveEvent {
+	localized := map[string]string{"locale": locale}
+	for name, value := range attributes {
+		localized[name] = value
+	}
+	e.LocalizedAttributes = append(e.LocalizedAttributes, localized)
+	return e
+}
+
+func (e *ProactiveEvent) validateExpiry() error {
+	expiry := e.ExpiryTime.Sub(e.Timestamp)
+	if expiry < ProactiveEventMinExpiry || expiry > ProactiveEventMaxExpiry {
+		return errInvalidProactiveEventExpiry
+	}
+	return nil
+}
+
+// MessageAlertEvent notifies the customer about new messages (schema AMAZON.MessageAlert.Activated).
+type MessageAlertEvent struct {
+	State struct {
+		// Status is UNREAD or FLAGGED
+		Status string `json:"status"`
+		// Freshness is NEW or OVERDUE
+		Freshness string `json:"freshness,omitempty"`
+	} `json:"state"`
+	MessageGroup struct {
+		Creator struct {
+			Name string `json:"name"`
+		} `json:"creator"`
+		Count int `json:"count"`
+		// Urgency is URGENT or empty
+		Urgency string `json:"urgency,omitempty"`
+	} `json:"messageGroup"`
+}
+
+// EventName of the schema
+func (e *MessageAlertEvent) EventName() string {
+	return "AMAZON.MessageAlert.Activated"
+}
+
+// NewMessageAlertEvent creates a event for the given number of unread messages of the creator.
+func NewMessageAlertEvent(creator string, count int) *MessageAlertEvent {
+	event := &MessageAlertEvent{}
+	event.State.Status = "UNREAD"
+	event.State.Freshness = "NEW"
+	event.MessageGroup.Creator.Name = creator
+	event.MessageGroup.Count = count
+	return event
+}
+
+// OrderStatusEvent notifies the customer about the status of a order (schema AMAZON.OrderStatus.Updated).
+type OrderStatusEvent struct {
+	State struct {
+		// Status like ORDER_RECEIVED, ORDER_SHIPPED, ORDER_OUT_FOR_DELIVERY, ORDER_DELIVERED or ORDER_PREORDER_RECEIVED
+		Status          string `json:"status"`
+		DeliveryDetails *struct {
+			ExpectedArrival time.Time `json:"expectedArrival"`
+		} `json:"deliveryDetails,omitempty"`
+	} `json:"state"`
+	Order struct {
+		Seller struct {
+			Name string `json:"name"`
+		} `json:"seller"`
+	} `json:"order"`
+}
+
+// EventName of the schema
+func (e *OrderStatusEvent) EventName() string {
+	return "AMAZON.OrderStatus.Updated"
+}
+
+// NewOrderStatusEvent creates a event for the given order status and seller.
+func NewOrderStatusEvent(status, seller string) *OrderStatusEvent {
+	event := &OrderStatusEvent{}
+	event.State.Status = status
+	event.Order.Seller.Name = seller
+	return event
+}
+
+// SetExpectedArrival sets the delivery details of a shipped order.
+func (e *OrderStatusEvent) SetExpectedArrival(expectedArrival time.Time) *OrderStatusEvent {
+	e.State.DeliveryDetails = &struct {
+		ExpectedArrival time.Time `json:"expectedArrival"`
+	}{ExpectedArrival: expectedArrival.UTC()}
+	return e
+}
+
+// WeatherAlertEvent notifies the customer about a weather alert (schema AMAZON.WeatherAlert.Activated).
+type WeatherAlertEvent struct {
+	WeatherAlert struct {
+		Source string `json:"source"`
+		// AlertType like DEFAULT, TORNADO, HURRICANE, SNOW_STORM, THUNDER_STORM
+		AlertType string `json:"alertType"`
+	} `json:"weatherAlert"`
+}
+
+// EventName of the schema
+func (e *WeatherAlertEvent) EventName() string {
+	return "AMAZON.WeatherAlert.Activated"
+}
+
+// NewWeatherAlertEvent creates a event for the given alert type and source.
+func NewWeatherAlertEvent(alertType, source string) *WeatherAlertEvent {
+	event := &WeatherAlertEvent{}
+	event.WeatherAlert.AlertType = alertType
+	event.WeatherAlert.Source = source
+	return event
+}
+
+type proactiveEventsService struct {
+	client      *ServiceClient
+	lwa         *LWAClient
+	apiEndpoint string
+	stage       string
+}
+
+// NewProactiveEventsService creates a proactive events service for the API endpoint of the region and the stage.
+// The access tokens are requested with the lwa client and the events are sent with the given client.
+func NewProactiveEventsService(client *ServiceClient, lwa *LWAClient, apiEndpoint, stage string) ProactiveEventsService {
+	return &proactiveEventsService{
+		client:      client,
+		lwa:         lwa,
+		apiEndpoint: apiEndpoint,
+		stage:       stage,
+	}
+}
+
+func (s *proactiveEventsService) SendEvent(ctx context.Context, event *ProactiveEvent) error {
+	if err := event.validateExpiry(); err != nil {
+		return err
+	}
+	accessToken, err := s.lwa.GetAccessToken(ctx, LWAScopeProactiveEvents)
+	if err != nil {
+		return err
+	}
+	path := "/v1/proactiveEvents"
+	if s.stage == ProactiveEventsStageDevelopment {
+		path += "/stages/development"
+	}
+	return s.client.Do(ctx, http.MethodPost, s.apiEndpoint, path, accessToken, event, nil)
+}
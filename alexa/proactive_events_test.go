@@ -0,0 +1,102 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newProactiveEventsTestServer(received chan map[string]interface{}) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-alexa::proactive_events" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		var event map[string]interface{}
+		json.Unmarshal(body, &event)
+		event["path"] = r.URL.Path
+		received <- event
+		w.WriteHeader(http.StatusAccepted)
+	}))
+}
+
+func TestProactiveEventsService(t *testing.T) {
+	received := make(chan map[string]interface{}, 1)
+	lwa := newLWAScopeTestServer()
+	api := newProactiveEventsTestServer(received)
+	defer lwa.Close()
+	defer api.Close()
+
+	lwaClient := NewLWAClient("clientId", "secret")
+	lwaClient.TokenURL = lwa.URL
+	service := NewProactiveEventsService(NewServiceClient(nil), lwaClient, api.URL, ProactiveEventsStageDevelopment)
+
+	event := NewProactiveEvent("ref-1", NewMessageAlertEvent("Andy", 5), time.Hour).SetUnicast("amzn1.ask.account.1")
+	require.NoError(t, service.SendEvent(context.Background(), event))
+	body := <-received
+	assert.Equal(t, "/v1/proactiveEvents/stages/development", body["path"])
+	assert.Equal(t, "ref-1", body["referenceId"])
+	assert.Equal(t, map[string]interface{}{
+		"name": "AMAZON.MessageAlert.Activated",
+		"payload": map[string]interface{}{
+			"state": map[string]interface{}{"status": "UNREAD", "freshness": "NEW"},
+			"messageGroup": map[string]interface{}{
+				"creator": map[string]interface{}{"name": "Andy"},
+				"count":   float64(5),
+			},
+		},
+	}, body["event"])
+	assert.Equal(t, map[string]interface{}{
+		"type":    "Unicast",
+		"payload": map[string]interface{}{"user": "amzn1.ask.account.1"},
+	}, body["relevantAudience"])
+
+	// Live stage
+	service = NewProactiveEventsService(NewServiceClient(nil), lwaClient, api.URL, ProactiveEventsStageLive)
+	event = NewProactiveEvent("ref-2", NewWeatherAlertEvent("TORNADO", "localizedattribute:source"), 2*time.Hour).
+		AddLocalizedAttributes("en-US", map[string]string{"source": "Weather Service"})
+	require.NoError(t, service.SendEvent(context.Background(), event))
+	body = <-received
+	assert.Equal(t, "/v1/proactiveEvents", body["path"])
+	assert.Equal(t, "Multicast", body["relevantAudience"].(map[string]interface{})["type"])
+	assert.Equal(t, []interface{}{map[string]interface{}{"locale": "en-US", "source": "Weather Service"}}, body["localizedAttributes"])
+	timestamp, _ := time.Parse(time.RFC3339, body["timestamp"].(string))
+	expiry, _ := time.Parse(time.RFC3339, body["expiryTime"].(string))
+	assert.Equal(t, 2*time.Hour, expiry.Sub(timestamp))
+}
+
+func TestProactiveEventsServiceExpiry(t *testing.T) {
+	received := make(chan map[string]interface{}, 1)
+	lwa := newLWAScopeTestServer()
+	api := newProactiveEventsTestServer(received)
+	defer lwa.Close()
+	defer api.Close()
+
+	lwaClient := NewLWAClient("clientId", "secret")
+	lwaClient.TokenURL = lwa.URL
+	service := NewProactiveEventsService(NewServiceClient(nil), lwaClient, api.URL, ProactiveEventsStageLive)
+
+	err := service.SendEvent(context.Background(), NewProactiveEvent("ref", NewMessageAlertEvent("Andy", 1), time.Minute))
+	assert.Equal(t, errInvalidProactiveEventExpiry, err)
+	err = service.SendEvent(context.Background(), NewProactiveEvent("ref", NewMessageAlertEvent("Andy", 1), 25*time.Hour))
+	assert.Equal(t, errInvalidProactiveEventExpiry, err)
+}
+
+func TestOrderStatusEvent(t *testing.T) {
+	arrival := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	event := NewOrderStatusEvent("ORDER_SHIPPED", "localizedattribute:sellerName").SetExpectedArrival(arrival)
+	bytes, _ := json.Marshal(event)
+	assert.JSONEq(t, `{
+		"state": {"status": "ORDER_SHIPPED", "deliveryDetails": {"expectedArrival": "2021-06-01T12:00:00Z"}},
+		"order": {"seller": {"name": "localizedattribute:sellerName"}}
+	}`, string(bytes))
+	assert.Equal(t, "AMAZON.OrderStatus.Updated", event.EventName())
+}
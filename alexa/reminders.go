@@ -0,0 +1,288 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// RemindersService provides methods to manage the reminders of a customer. The skill needs the reminders permission 'alexa::alerts:reminders:skill:readwrite'.
+type RemindersService interface {
+	// CreateReminder creates a new reminder.
+	CreateReminder(ctx context.Context, system *System, reminder *ReminderRequest) (*ReminderResponse, error)
+	// GetReminder reads the reminder with the given alert token.
+	GetReminder(ctx context.Context, system *System, alertToken string) (*Reminder, error)
+	// UpdateReminder replaces the reminder with the given alert token.
+	UpdateReminder(ctx context.Context, system *System, alertToken string, reminder *ReminderRequest) (*ReminderResponse, error)
+	// DeleteReminder deletes the reminder with the given alert token.
+	DeleteReminder(ctx context.Context, system *System, alertToken string) error
+	// GetReminders lists all reminders created by the skill for the customer.
+	GetReminders(ctx context.Context, system *System) (*ReminderList, error)
+	// IsNotAuthorizedError returns true if the customer did not grant the reminders permission.
+	IsNotAuthorizedError(err error) bool
+	// IsValidationError returns true if the reminder was rejected by the service. The error is a *ReminderValidationError.
+	IsValidationError(err error) bool
+}
+
+// Reminder trigger types
+const (
+	ReminderTriggerAbsolute = "SCHEDULED_ABSOLUTE"
+	ReminderTriggerRelative = "SCHEDULED_RELATIVE"
+)
+
+// reminderTimeFormat is used for the request time and the scheduled time of reminders. The scheduled time is a local time in the time zone of the trigger.
+const reminderTimeFormat = "2006-01-02T15:04:05.000"
+
+// ReminderRequest is sent to create or update a reminder.
+type ReminderRequest struct {
+	// RequestTime is the UTC time the reminder was requested by the customer
+	RequestTime      string                   `json:"requestTime"`
+	Trigger          ReminderTrigger          `json:"trigger"`
+	AlertInfo        ReminderAlertInfo        `json:"alertInfo"`
+	PushNotification ReminderPushNotification `json:"pushNotification"`
+}
+
+// ReminderTrigger defines when the reminder is triggered.
+type ReminderTrigger struct {
+	// Type is SCHEDULED_ABSOLUTE or SCHEDULED_RELATIVE
+	Type string `json:"type"`
+	// ScheduledTime is the local time for SCHEDULED_ABSOLUTE reminders
+	ScheduledTime string `json:"scheduledTime,omitempty"`
+	// OffsetInSeconds is the time until the reminder is triggered for SCHEDULED_RELATIVE reminders
+	OffsetInSeconds int `json:"offsetInSeconds,omitempty"`
+	// TimeZoneID like 'America/Los_Angeles'. If empty the time zone of the device is used.
+	TimeZoneID string              `json:"timeZoneId,omitempty"`
+	Recurrence *ReminderRecurrence `json:"recurrence,omitempty"`
+}
+
+// ReminderRecurrence defines how a SCHEDULED_ABSOLUTE reminder repeats. Either Freq or RecurrenceRules must be used.
+type ReminderRecurrence struct {
+	// Freq is WEEKLY or DAILY
+	Freq string `json:"freq,omitempty"`
+	// ByDay contains the days for WEEKLY reminders, e.g. MO, TU
+	ByDay    []string `json:"byDay,omitempty"`
+	Interval int      `json:"interval,omitempty"`
+	// StartDateTime and EndDateTime limit the recurrence rules
+	StartDateTime string `json:"startDateTime,omitempty"`
+	EndDateTime   string `json:"endDateTime,omitempty"`
+	// RecurrenceRules in RFC 5545 format, e.g. 'FREQ=DAILY;BYHOUR=8;BYMINUTE=0;BYSECOND=0'
+	RecurrenceRules []string `json:"recurrenceRules,omitempty"`
+}
+
+// ReminderAlertInfo contains what is spoken when the reminder is triggered.
+type ReminderAlertInfo struct {
+	SpokenInfo struct {
+		Content []ReminderSpokenContent `json:"content"`
+	} `json:"spokenInfo"`
+}
+
+// ReminderSpokenContent is the text spoken for a single locale.
+type ReminderSpokenContent struct {
+	Locale string `json:"locale"`
+	Text   string `json:"text"`
+	SSML   string `json:"ssml,omitempty"`
+}
+
+// ReminderPushNotification enables or disables the push notification to the Alexa app.
+type ReminderPushNotification struct {
+	// Status is ENABLED or DISABLED
+	Status string `json:"status"`
+}
+
+// ReminderResponse is returned if a reminder is created or updated.
+type ReminderResponse struct {
+	AlertToken  string `json:"alertToken"`
+	CreatedTime string `json:"createdTime"`
+	UpdatedTime string `json:"updatedTime"`
+	// Status is ON or COMPLETED
+	Status  string `json:"status"`
+	Version string `json:"version"`
+	Href    string `json:"href"`
+}
+
+// Reminder is a existing reminder including its trigger and alert information.
+type Reminder struct {
+	ReminderResponse
+	Trigger          ReminderTrigger          `json:"trigger"`
+	AlertInfo        ReminderAlertInfo        `json:"alertInfo"`
+	PushNotification ReminderPushNotification `json:"pushNotification"`
+}
+
+// ReminderList contains all reminders of the customer created by the skill.
+type ReminderList struct {
+	TotalCount string     `json:"totalCount"`
+	Alerts     []Reminder `json:"alerts"`
+	Links      struct {
+		Next string `json:"next,omitempty"`
+	} `json:"links"`
+}
+
+// ReminderValidationError is returned if the reminders API rejects a request, e.g. because the scheduled time is in the past.
+type ReminderValidationError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+}
+
+func (e *ReminderValidationError) Error() string {
+	return fmt.Sprintf("Reminder rejected with StatusCode %d: %s %s", e.StatusCode, e.Code, e.Message)
+}
+
+var errReminderPermissionMissing = errors.New("The customer did not grant the reminders permission")
+
+// NewAbsoluteReminder creates a reminder triggered at the given local time in the given time zone. If timeZoneID is empty the time zone of the device is used.
+func NewAbsoluteReminder(scheduledTime time.Time, timeZoneID string) *ReminderRequest {
+	reminder := newReminderRequest()
+	reminder.Trigger = ReminderTrigger{
+		Type:          ReminderTriggerAbsolute,
+		ScheduledTime: scheduledTime.Format(reminderTimeFormat),
+		TimeZoneID:    timeZoneID,
+	}
+	return reminder
+}
+
+// NewRelativeReminder creates a reminder triggered after the given duration.
+func NewRelativeReminder(offset time.Duration) *ReminderRequest {
+	reminder := newReminderRequest()
+	reminder.Trigger = ReminderTrigger{
+		Type:            ReminderTriggerRelative,
+		OffsetInSeconds: int(offset.Seconds()),
+	}
+	return reminder
+}
+
+func newReminderRequest() *ReminderRequest {
+	reminder := &ReminderRequest{
+		RequestTime: time.Now().UTC().Format(reminderTimeFormat),
+		PushNotification: ReminderPushNotification{
+			Status: "ENABLED",
+		},
+	}
+	reminder.AlertInfo.SpokenInfo.Content = make([]ReminderSpokenContent, 0)
+	return reminder
+}
+
+// AddSpokenContent adds the text spoken for the given locale. ssml is optional.
+func (r *ReminderRequest) AddSpokenContent(locale, text, ssml string) *ReminderRequest {
+	r.AlertInfo.SpokenInfo.Content = append(r.AlertInfo.SpokenInfo.Content, ReminderSpokenContent{
+		Locale: locale,
+		Text:   text,
+		SSML:   ssml,
+	})
+	return r
+}
+
+// SetRecurrence sets the recurrence of a SCHEDULED_ABSOLUTE reminder.
+func (r *ReminderRequest) SetRecurrence(recurrence *ReminderRecurrence) *ReminderRequest {
+	r.Trigger.Recurrence = recurrence
+	return r
+}
+
+type remindersService struct {
+	client *ServiceClient
+	// lwa and apiEndpoint are only set for out of session calls
+	lwa         *LWAClient
+	apiEndpoint string
+}
+
+var remindersServiceInstance = NewRemindersService(defaultServiceClient)
+
+// NewRemindersService creates a reminders service sending the requests with the given client.
+func NewRemindersService(client *ServiceClient) RemindersService {
+	return &remindersService{client: client}
+}
+
+// NewOutOfSessionRemindersService creates a reminders service for calls outside of a skill session to the API endpoint of the region.
+// The access tokens are requested with the lwa client for the LWAScopeReminders scope, the system passed to the methods is ignored and may be nil.
+func NewOutOfSessionRemindersService(client *ServiceClient, lwa *LWAClient, apiEndpoint string) RemindersService {
+	return &remindersService{
+		client:      client,
+		lwa:         lwa,
+		apiEndpoint: apiEndpoint,
+	}
+}
+
+func (s *remindersService) executeRemindersCall(ctx context.Context, method string, system *System, alertToken string, body, targetObj interface{}) error {
+	path := "/v1/alerts/reminders"
+	if alertToken != "" {
+		path += "/" + url.PathEscape(alertToken)
+	}
+	var apiEndpoint, accessToken string
+	if s.lwa != nil {
+		var err error
+		if accessToken, err = s.lwa.GetAccessToken(ctx, LWAScopeReminders); err != nil {
+			return err
+		}
+		apiEndpoint = s.apiEndpoint
+	} else {
+		apiEndpoint, accessToken = system.APIEndpoint, system.APIAccessToken
+	}
+	err := s.client.Do(ctx, method, apiEndpoint, path, accessToken, body, targetObj)
+	var serviceErr *ServiceError
+	if errors.As(err, &serviceErr) {
+		if serviceErr.StatusCode == http.StatusUnauthorized {
+			return errReminderPermissionMissing
+		}
+		if serviceErr.StatusCode == http.StatusBadRequest {
+			validationErr := &ReminderValidationError{StatusCode: serviceErr.StatusCode}
+			json.Unmarshal(serviceErr.Body, validationErr)
+			return validationErr
+		}
+	}
+	return err
+}
+
+func (s *remindersService) CreateReminder(ctx context.Context, system *System, reminder *ReminderRequest) (*ReminderResponse, error) {
+	var response ReminderResponse
+	err := s.executeRemindersCall(ctx, http.MethodPost, system, "", reminder, &response)
+	return &response, err
+}
+
+func (s *remindersService) GetReminder(ctx context.Context, system *System, alertToken string) (*Reminder, error) {
+	var reminder Reminder
+	err := s.executeRemindersCall(ctx, http.MethodGet, system, alertToken, nil, &reminder)
+	return &reminder, err
+}
+
+func (s *remindersService) UpdateReminder(ctx context.Context, system *System, alertToken string, reminder *ReminderRequest) (*ReminderResponse, error) {
+	var response ReminderResponse
+	err := s.executeRemindersCall(ctx, http.MethodPut, system, alertToken, reminder, &response)
+	return &response, err
+}
+
+func (s *remindersService) DeleteReminder(ctx context.Context, system *System, alertToken string) error {
+	return s.executeRemindersCall(ctx, http.MethodDelete, system, alertToken, nil, nil)
+}
+
+func (s *remindersService) GetReminders(ctx context.Context, system *System) (*ReminderList, error) {
+	var list ReminderList
+	err := s.executeRemindersCall(ctx, http.MethodGet, system, "", nil, &list)
+	return &list, err
+}
+
+func (s *remindersService) IsNotAuthorizedError(err error) bool {
+	return errors.Is(err, errReminderPermissionMissing) || errors.Is(err, errorForbidden)
+}
+
+func (s *remindersService) IsValidationError(err error) bool {
+	var validationErr *ReminderValidationError
+	return errors.As(err, &validationErr)
+}
+
+// ReminderEventRequest is send if a reminder created by the skill was created, started, updated, deleted or changed its status.
+// The request has no session and the response is ignored.
+type ReminderEventRequest struct {
+	CommonRequest
+	Body struct {
+		// AlertToken of the reminder. Not set for ReminderDeleted events.
+		AlertToken string `json:"alertToken,omitempty"`
+		// AlertTokens of the deleted reminders for ReminderDeleted events.
+		AlertTokens []string `json:"alertTokens,omitempty"`
+		// Status is ON or COMPLETED for ReminderStatusChanged events
+		Status string `json:"status,omitempty"`
+	} `json:"body"`
+}
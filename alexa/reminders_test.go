@@ -0,0 +1,196 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRemindersTestServer() *httptest.Server {
+	reminders := make(map[string]*ReminderRequest)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Header.Get("Authorization") {
+		case "Bearer tokenOk", "Bearer token-" + LWAScopeReminders:
+		case "Bearer tokenNoPermission":
+			http.Error(w, `{"code":"UNAUTHORIZED","message":"No permission"}`, http.StatusUnauthorized)
+			return
+		default:
+			http.Error(w, "unexpected error", http.StatusInternalServerError)
+			return
+		}
+		alertToken := strings.TrimPrefix(r.URL.Path, "/v1/alerts/reminders")
+		alertToken = strings.TrimPrefix(alertToken, "/")
+
+		switch r.Method {
+		case http.MethodPost, http.MethodPut:
+			var reminder ReminderRequest
+			json.NewDecoder(r.Body).Decode(&reminder)
+			if len(reminder.AlertInfo.SpokenInfo.Content) == 0 {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"code":"INVALID_ALERT_INFO","message":"Spoken info is missing"}`))
+				return
+			}
+			if alertToken == "" {
+				alertToken = "token-1"
+			}
+			reminders[alertToken] = &reminder
+			json.NewEncoder(w).Encode(ReminderResponse{AlertToken: alertToken, Status: "ON", Version: "1"})
+		case http.MethodGet:
+			if alertToken == "" {
+				list := ReminderList{TotalCount: "1"}
+				for token, reminder := range reminders {
+					list.Alerts = append(list.Alerts, Reminder{
+						ReminderResponse: ReminderResponse{AlertToken: token},
+						Trigger:          reminder.Trigger,
+					})
+				}
+				json.NewEncoder(w).Encode(list)
+				return
+			}
+			reminder, ok := reminders[alertToken]
+			if !ok {
+				http.Error(w, `{"code":"NOT_FOUND"}`, http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(Reminder{
+				ReminderResponse: ReminderResponse{AlertToken: alertToken},
+				Trigger:          reminder.Trigger,
+				AlertInfo:        reminder.AlertInfo,
+			})
+		case http.MethodDelete:
+			delete(reminders, alertToken)
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+}
+
+func TestRemindersService(t *testing.T) {
+	ts := newRemindersTestServer()
+	defer ts.Close()
+
+	remindersService := GetRemindersService()
+	system := &System{
+		APIAccessToken: "tokenOk",
+		APIEndpoint:    ts.URL,
+	}
+
+	scheduled := time.Date(2021, 6, 1, 8, 30, 0, 0, time.UTC)
+	reminder := NewAbsoluteReminder(scheduled, "Europe/Berlin").
+		AddSpokenContent("en-US", "Water the plants", "").
+		AddSpokenContent("de-DE", "Blumen gießen", "<speak>Blumen gießen</speak>").
+		SetRecurrence(&ReminderRecurrence{Freq: "WEEKLY", ByDay: []string{"MO", "TH"}})
+
+	created, err := remindersService.CreateReminder(context.Background(), system, reminder)
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", created.AlertToken)
+	assert.Equal(t, "ON", created.Status)
+
+	read, err := remindersService.GetReminder(context.Background(), system, created.AlertToken)
+	require.NoError(t, err)
+	assert.Equal(t, ReminderTriggerAbsolute, read.Trigger.Type)
+	assert.Equal(t, "2021-06-01T08:30:00.000", read.Trigger.ScheduledTime)
+	assert.Equal(t, "Europe/Berlin", read.Trigger.TimeZoneID)
+	assert.Equal(t, []string{"MO", "TH"}, read.Trigger.Recurrence.ByDay)
+	assert.Equal(t, "de-DE", read.AlertInfo.SpokenInfo.Content[1].Locale)
+	assert.Equal(t, "<speak>Blumen gießen</speak>", read.AlertInfo.SpokenInfo.Content[1].SSML)
+
+	updated, err := remindersService.UpdateReminder(context.Background(), system, created.AlertToken, NewRelativeReminder(10*time.Minute).AddSpokenContent("en-US", "Tea is ready", ""))
+	require.NoError(t, err)
+	assert.Equal(t, created.AlertToken, updated.AlertToken)
+
+	list, err := remindersService.GetReminders(context.Background(), system)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Alerts))
+	assert.Equal(t, ReminderTriggerRelative, list.Alerts[0].Trigger.Type)
+	assert.Equal(t, 600, list.Alerts[0].Trigger.OffsetInSeconds)
+
+	require.NoError(t, remindersService.DeleteReminder(context.Background(), system, created.AlertToken))
+	_, err = remindersService.GetReminder(context.Background(), system, created.AlertToken)
+	assert.Error(t, err)
+	assert.False(t, remindersService.IsNotAuthorizedError(err))
+	assert.False(t, remindersService.IsValidationError(err))
+}
+
+func TestRemindersServiceErrors(t *testing.T) {
+	ts := newRemindersTestServer()
+	defer ts.Close()
+
+	remindersService := GetRemindersService()
+	system := &System{
+		APIAccessToken: "tokenOk",
+		APIEndpoint:    ts.URL,
+	}
+
+	// Validation failure
+	_, err := remindersService.CreateReminder(context.Background(), system, NewRelativeReminder(time.Minute))
+	require.Error(t, err)
+	assert.True(t, remindersService.IsValidationError(err))
+	assert.False(t, remindersService.IsNotAuthorizedError(err))
+	validationErr := err.(*ReminderValidationError)
+	assert.Equal(t, http.StatusBadRequest, validationErr.StatusCode)
+	assert.Equal(t, "INVALID_ALERT_INFO", validationErr.Code)
+	assert.Equal(t, "Spoken info is missing", validationErr.Message)
+	assert.True(t, remindersService.IsValidationError(fmt.Errorf("create reminder: %w", err)))
+
+	// Missing permission
+	system.APIAccessToken = "tokenNoPermission"
+	_, err = remindersService.GetReminders(context.Background(), system)
+	require.Error(t, err)
+	assert.True(t, remindersService.IsNotAuthorizedError(err))
+	assert.False(t, remindersService.IsValidationError(err))
+	assert.True(t, remindersService.IsNotAuthorizedError(fmt.Errorf("get reminders: %w", err)))
+
+	// Some other error
+	system.APIAccessToken = "random token"
+	err = remindersService.DeleteReminder(context.Background(), system, "token-1")
+	require.Error(t, err)
+	assert.False(t, remindersService.IsNotAuthorizedError(err))
+	assert.False(t, remindersService.IsValidationError(err))
+}
+
+func TestOutOfSessionRemindersService(t *testing.T) {
+	lwa := newLWAScopeTestServer()
+	defer lwa.Close()
+	ts := newRemindersTestServer()
+	defer ts.Close()
+
+	lwaClient := NewLWAClient("clientId", "secret")
+	lwaClient.TokenURL = lwa.URL
+	remindersService := NewOutOfSessionRemindersService(NewServiceClient(nil), lwaClient, ts.URL)
+
+	created, err := remindersService.CreateReminder(context.Background(), nil, NewRelativeReminder(time.Hour).AddSpokenContent("en-US", "Order is ready", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "token-1", created.AlertToken)
+}
+
+func TestReminderEvent(t *testing.T) {
+	eventRequest, _ := ioutil.ReadFile("../resources/reminder_event_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(eventRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnReminderEvent: func(request *ReminderEventRequest, response *ResponseEnvelope) {
+			called = true
+			assert.Equal(t, "Reminders.ReminderStatusChanged", request.Type)
+			assert.Equal(t, "token-1", request.Body.AlertToken)
+			assert.Equal(t, "COMPLETED", request.Body.Status)
+			assert.Equal(t, "", request.Session.SessionID)
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+
+	bytes, _ := json.Marshal(response)
+	assert.JSONEq(t, `{"version":"1.0"}`, string(bytes))
+}
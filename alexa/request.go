@@ -1,8 +1,10 @@
 package alexa
 
 import (
+	"context"
 	"encoding/json"
 	"log"
+	"strings"
 	"time"
 )
 
@@ -13,6 +15,12 @@ type RequestEnvelope struct {
 	// one of the request structs
 	Request interface{} `json:"request"`
 	Context Context     `json:"context"`
+	// rawRequest keeps the request as sent by alexa, so the typed request is decoded without losing number precision
+	rawRequest json.RawMessage
+	// ctx is the context of the incoming http request or lambda invocation
+	ctx context.Context
+	// services is the service factory of the skill handling the request
+	services ServiceFactory
 }
 
 // Session object contained in standard request types like LaunchRequest, IntentRequest, SessionEndedRequest and GameEngine interface.
@@ -39,6 +47,8 @@ type User struct {
 type Context struct {
 	System      System      `json:"System"`
 	AudioPlayer AudioPlayer `json:"audioPlayer"`
+	// Viewport is only present if the device has a screen
+	Viewport *Viewport `json:"Viewport,omitempty"`
 }
 
 // System object that provides information about the current state of the Alexa service and the device interacting with your skill.
@@ -48,11 +58,20 @@ type System struct {
 	Application    Application `json:"application"`
 	Device         Device      `json:"device"`
 	User           User        `json:"user"`
+	// Person is only set if Alexa recognized the voice of a speaker registered in the household
+	Person *Person `json:"person,omitempty"`
+}
+
+// Person contains the id and access token of the recognized speaker.
+type Person struct {
+	PersonID    string `json:"personId"`
+	AccessToken string `json:"accessToken,omitempty"`
 }
 
 // Device object providing information about the device used to send the request.
 type Device struct {
-	DeviceID            string                 `json:"deviceId"`
+	DeviceID string `json:"deviceId"`
+	// SupportedInterfaces contains a entry for every interface the device supports, use the Supports* methods to check it
 	SupportedInterfaces map[string]interface{} `json:"supportedInterfaces"`
 }
 
@@ -67,6 +86,8 @@ type AudioPlayer struct {
 type requestEnvelopeDataProvider interface {
 	setContext(ctx *Context)
 	setSession(session *Session)
+	setRequestContext(ctx context.Context)
+	setServices(services ServiceFactory)
 }
 
 // CommonRequest contains the attributes all alexa requests have in common.
@@ -76,13 +97,17 @@ type CommonRequest struct {
 	Timestamp string `json:"timestamp"`
 	Locale    string `json:"locale"`
 	// Set manually from request envelope
-	Session *Session
-	Context *Context
+	Session  *Session
+	Context  *Context
+	ctx      context.Context
+	services ServiceFactory
 }
 
 // LaunchRequest send by Alexa if a skill is started.
 type LaunchRequest struct {
 	CommonRequest
+	// Task is set if the skill is launched by another skill or a Alexa routine to perform a task
+	Task *LaunchRequestTask `json:"task,omitempty"`
 }
 
 // IntentRequest is send if a intent is invoked.
@@ -131,10 +156,34 @@ type SystemExceptionEncounteredRequest struct {
 
 // GetTypedRequest provides the request object mapped to the given struct
 func (requestEnvelope *RequestEnvelope) getTypedRequest(requestObj interface{}) error {
-	data, _ := json.Marshal(requestEnvelope.Request)
 	requestObj.(requestEnvelopeDataProvider).setContext(&requestEnvelope.Context)
 	requestObj.(requestEnvelopeDataProvider).setSession(&requestEnvelope.Session)
-	return json.Unmarshal(data, &requestObj)
+	requestObj.(requestEnvelopeDataProvider).setRequestContext(requestEnvelope.ctx)
+	requestObj.(requestEnvelopeDataProvider).setServices(requestEnvelope.services)
+	data := requestEnvelope.rawRequest
+	if data == nil {
+		// The envelope was not decoded from JSON, e.g. in tests
+		data, _ = json.Marshal(requestEnvelope.Request)
+	}
+	return json.Unmarshal(data, requestObj)
+}
+
+// UnmarshalJSON decodes the envelope and keeps the raw bytes of the request for getTypedRequest.
+func (requestEnvelope *RequestEnvelope) UnmarshalJSON(data []byte) error {
+	type plainEnvelope RequestEnvelope
+	var envelope struct {
+		plainEnvelope
+		Request json.RawMessage `json:"request"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return err
+	}
+	*requestEnvelope = RequestEnvelope(envelope.plainEnvelope)
+	if len(envelope.Request) == 0 {
+		return nil
+	}
+	requestEnvelope.rawRequest = envelope.Request
+	return json.Unmarshal(envelope.Request, &requestEnvelope.Request)
 }
 
 func (cr *CommonRequest) setContext(ctx *Context) {
@@ -143,17 +192,46 @@ func (cr *CommonRequest) setContext(ctx *Context) {
 func (cr *CommonRequest) setSession(session *Session) {
 	cr.Session = session
 }
+func (cr *CommonRequest) setRequestContext(ctx context.Context) {
+	cr.ctx = ctx
+}
+func (cr *CommonRequest) setServices(services ServiceFactory) {
+	cr.services = services
+}
+
+// RequestContext returns the context of the incoming http request or lambda invocation. It is canceled if the request is aborted.
+func (cr *CommonRequest) RequestContext() context.Context {
+	if cr.ctx == nil {
+		return context.Background()
+	}
+	return cr.ctx
+}
 
-// VerifyTimestamp checks if the the timestamp is not older than 30 seconds
+// Services returns the service factory of the skill to obtain the clients for the Alexa APIs.
+func (cr *CommonRequest) Services() ServiceFactory {
+	if cr.services == nil {
+		return defaultServiceFactory
+	}
+	return cr.services
+}
+
+// VerifyTimestamp checks if the the timestamp is not older than 30 seconds.
+// AlexaSkillEvent requests may be sent up to one hour after the event occurred.
 func (requestEnvelope *RequestEnvelope) verifyTimestamp() bool {
-	timestampStr := requestEnvelope.Request.(map[string]interface{})["timestamp"].(string)
+	request, _ := requestEnvelope.Request.(map[string]interface{})
+	timestampStr, ok := request["timestamp"].(string)
+	if !ok {
+		log.Println("Request has no timestamp ", requestEnvelope.Request)
+		return false
+	}
 
 	requestTimestamp, err := time.Parse("2006-01-02T15:04:05Z", timestampStr)
 	if err != nil {
 		log.Println("Error parsing request timestamp with value ", timestampStr, requestEnvelope.Request)
 	}
-	if time.Since(requestTimestamp).Seconds() < (time.Duration(30) * time.Second).Seconds() {
-		return true
+	tolerance := time.Duration(30) * time.Second
+	if requestType, _ := request["type"].(string); strings.HasPrefix(requestType, "AlexaSkillEvent.") {
+		tolerance = time.Hour
 	}
-	return false
+	return time.Since(requestTimestamp) < tolerance
 }
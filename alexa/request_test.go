@@ -52,7 +52,10 @@ func TestIntentRequest(t *testing.T) {
 		assert.Equal(t, "ZodiacSign", request.Intent.Slots["ZodiacSign"].Name, "Name does not match")
 		assert.Equal(t, "virgo", request.Intent.Slots["ZodiacSign"].Value, "Value does not match")
 		assert.Equal(t, "NONE", request.Intent.Slots["ZodiacSign"].ConfirmationStatus, "ConfirmationStatus does not match")
-
+		if assert.NotNil(t, request.Context.System.Person, "Person is missing") {
+			assert.Equal(t, "amzn1.ask.person.ABCDEF0000000000000000000000", request.Context.System.Person.PersonID, "PersonID does not match")
+			assert.Equal(t, "personAccessToken", request.Context.System.Person.AccessToken, "AccessToken does not match")
+		}
 	}
 	_, err = r.handleRequest(&skill)
 
@@ -144,6 +147,35 @@ func TestTimestampOld(t *testing.T) {
 	}
 	assert.False(t, oldTimestamp.verifyTimestamp())
 }
+
+func TestTimestampSkillEvent(t *testing.T) {
+	timeformat := "2006-01-02T15:04:05Z"
+	// Skill events may be delivered up to one hour late
+	skillEvent := &RequestEnvelope{
+		Request: map[string]interface{}{
+			"type":      "AlexaSkillEvent.SkillEnabled",
+			"timestamp": time.Now().UTC().Add(-30 * time.Minute).Format(timeformat),
+		},
+	}
+	assert.True(t, skillEvent.verifyTimestamp())
+
+	oldSkillEvent := &RequestEnvelope{
+		Request: map[string]interface{}{
+			"type":      "AlexaSkillEvent.SkillEnabled",
+			"timestamp": time.Now().UTC().Add(-2 * time.Hour).Format(timeformat),
+		},
+	}
+	assert.False(t, oldSkillEvent.verifyTimestamp())
+
+	intentRequest := &RequestEnvelope{
+		Request: map[string]interface{}{
+			"type":      "IntentRequest",
+			"timestamp": time.Now().UTC().Add(-30 * time.Minute).Format(timeformat),
+		},
+	}
+	assert.False(t, intentRequest.verifyTimestamp())
+}
+
 func TestTimestampWrongFormat(t *testing.T) {
 	//Invalid format
 	invalidTimestamp := &RequestEnvelope{
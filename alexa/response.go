@@ -15,6 +15,10 @@ type Response struct {
 	// Use a pointer to be able to specify true,false and do not set it
 	ShouldEndSession *bool         `json:"shouldEndSession,omitempty"`
 	Directives       []interface{} `json:"directives,omitempty"`
+	// APIResponse contains the result of a API invoked by Alexa Conversations
+	APIResponse interface{} `json:"apiResponse,omitempty"`
+	// CanFulfillIntent is the answer to a CanFulfillIntentRequest
+	CanFulfillIntent *CanFulfillIntent `json:"canFulfillIntent,omitempty"`
 }
 
 //OutputSpeech containing the speech to render to the user.
@@ -0,0 +1,173 @@
+package alexa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// ServiceClient sends requests to the Alexa APIs. All Alexa service clients like the DeviceAddressService are built on it.
+// The zero value sends the requests with a default HTTP client without retries.
+type ServiceClient struct {
+	// HTTPClient sends the requests. A client with a timeout of 10 seconds is used if it is nil
+	HTTPClient *http.Client
+	// BaseURL overrides the API endpoint of the requests, e.g. to use a mock server. The API endpoint of the request is used if it is empty
+	BaseURL string
+	// MaxRetries is the number of retries if the API answers with 429 Too Many Requests.
+	// Requests with the idempotent methods GET, PUT and DELETE are also retried for a 5xx status code
+	MaxRetries int
+	// RetryBackoff is the wait time before the first retry. It is doubled for every further retry.
+	// The Retry-After header of a 429 Too Many Requests answer is used instead if it is set
+	RetryBackoff time.Duration
+}
+
+// ServiceError is returned if the Alexa API answers with a unexpected status code.
+type ServiceError struct {
+	StatusCode int
+	// RequestID is the X-Amzn-RequestId header of the response
+	RequestID string
+	// Body contains the error details sent by the Alexa API
+	Body []byte
+	// RetryAfter is the wait time requested with the Retry-After header of a 429 Too Many Requests answer
+	RetryAfter time.Duration
+}
+
+func (e *ServiceError) Error() string {
+	return fmt.Sprintf("Unexpected StatusCode %d, X-Amzn-RequestId=%s", e.StatusCode, e.RequestID)
+}
+
+// Is reports a 403 Forbidden answer as errorForbidden, so errors.Is(err, errorForbidden) detects missing permissions.
+func (e *ServiceError) Is(target error) bool {
+	return target == errorForbidden && e.StatusCode == http.StatusForbidden
+}
+
+// retryable returns true if the request can be sent again. A 5xx answer is only retried for idempotent methods,
+// because the API may have processed the request, e.g. a POST would create a reminder twice.
+func (e *ServiceError) retryable(method string) bool {
+	if e.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	idempotent := method == http.MethodGet || method == http.MethodPut || method == http.MethodDelete
+	return idempotent && e.StatusCode >= 500
+}
+
+var defaultHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+var defaultServiceClient = NewServiceClient(nil)
+
+// NewServiceClient creates a service client which retries failed requests two times. If httpClient is nil a client with a timeout of 10 seconds is used.
+func NewServiceClient(httpClient *http.Client) *ServiceClient {
+	return &ServiceClient{
+		HTTPClient:   httpClient,
+		MaxRetries:   2,
+		RetryBackoff: 100 * time.Millisecond,
+	}
+}
+
+// Do sends a request with the given method to the path of the Alexa API. apiEndpoint is used as base URL if the client has no BaseURL.
+// The body is sent as JSON if it is not nil. The JSON response is mapped to targetObj if it is not nil.
+// Responses with a status code other than 2xx are returned as *ServiceError.
+func (c *ServiceClient) Do(ctx context.Context, method, apiEndpoint, path, accessToken string, body, targetObj interface{}) error {
+	return c.DoWithHeader(ctx, method, apiEndpoint, path, accessToken, nil, body, targetObj)
+}
+
+// DoWithHeader works like Do and sends the given header fields in addition, e.g. the Accept-Language header.
+func (c *ServiceClient) DoWithHeader(ctx context.Context, method, apiEndpoint, path, accessToken string, header http.Header, body, targetObj interface{}) error {
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+	baseURL := apiEndpoint
+	if c.BaseURL != "" {
+		baseURL = c.BaseURL
+	}
+
+	backoff := c.RetryBackoff
+	for attempt := 0; ; attempt++ {
+		err := c.send(ctx, method, baseURL+path, accessToken, header, bodyBytes, targetObj)
+		serviceErr, ok := err.(*ServiceError)
+		if !ok || !serviceErr.retryable(method) || attempt >= c.MaxRetries {
+			return err
+		}
+		wait := backoff
+		if serviceErr.RetryAfter > 0 {
+			wait = serviceErr.RetryAfter
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		backoff *= 2
+	}
+}
+
+func (c *ServiceClient) send(ctx context.Context, method, url, accessToken string, header http.Header, bodyBytes []byte, targetObj interface{}) error {
+	var bodyReader io.Reader
+	if bodyBytes != nil {
+		bodyReader = bytes.NewReader(bodyBytes)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, bodyReader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	if bodyBytes != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, values := range header {
+		req.Header[name] = values
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = defaultHTTPClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &ServiceError{
+			StatusCode: resp.StatusCode,
+			RequestID:  resp.Header.Get("X-Amzn-RequestId"),
+			Body:       respBytes,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+	}
+	if targetObj == nil || len(respBytes) == 0 {
+		return nil
+	}
+	return json.Unmarshal(respBytes, targetObj)
+}
+
+// parseRetryAfter reads the Retry-After header, which contains either the seconds to wait or a HTTP date.
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return time.Until(date)
+	}
+	return 0
+}
@@ -0,0 +1,172 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServiceClientRetry(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		if calls == 1 {
+			http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			return
+		}
+		if calls == 2 {
+			http.Error(w, "Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"value":"ok"}`))
+	}))
+	defer ts.Close()
+
+	client := NewServiceClient(ts.Client())
+	client.RetryBackoff = time.Millisecond
+	var result struct {
+		Value string `json:"value"`
+	}
+	err := client.Do(context.Background(), http.MethodPut, ts.URL, "/v1/test", "token", map[string]string{"key": "value"}, &result)
+	require.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, "ok", result.Value)
+}
+
+func TestServiceClientRetryPost(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(w, "Too many requests", http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, "Unavailable", http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	client := NewServiceClient(ts.Client())
+	client.RetryBackoff = time.Millisecond
+	// A POST is retried after 429 but not after a 5xx, the request may have been processed
+	err := client.Do(context.Background(), http.MethodPost, ts.URL, "/v1/test", "token", map[string]string{"key": "value"}, nil)
+	var serviceErr *ServiceError
+	require.True(t, errors.As(err, &serviceErr))
+	assert.Equal(t, http.StatusServiceUnavailable, serviceErr.StatusCode)
+	assert.Equal(t, 2, calls)
+}
+
+func TestServiceClientErrors(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("X-Amzn-RequestId", "requestId")
+		switch r.URL.Path {
+		case "/forbidden":
+			http.Error(w, "Forbidden", http.StatusForbidden)
+		case "/invalid":
+			http.Error(w, `{"code":"INVALID"}`, http.StatusBadRequest)
+		default:
+			http.Error(w, "Internal error", http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	client := NewServiceClient(nil)
+	client.RetryBackoff = time.Millisecond
+
+	// Retries are exhausted
+	err := client.Do(context.Background(), http.MethodGet, ts.URL, "/error", "token", nil, nil)
+	var serviceErr *ServiceError
+	require.True(t, errors.As(err, &serviceErr))
+	assert.Equal(t, http.StatusInternalServerError, serviceErr.StatusCode)
+	assert.Equal(t, "requestId", serviceErr.RequestID)
+	assert.Equal(t, "Unexpected StatusCode 500, X-Amzn-RequestId=requestId", err.Error())
+	assert.Equal(t, 3, calls)
+
+	// Client errors are not retried
+	calls = 0
+	err = client.Do(context.Background(), http.MethodGet, ts.URL, "/invalid", "token", nil, nil)
+	require.True(t, errors.As(err, &serviceErr))
+	assert.Equal(t, http.StatusBadRequest, serviceErr.StatusCode)
+	assert.Equal(t, "{\"code\":\"INVALID\"}\n", string(serviceErr.Body))
+	assert.False(t, errors.Is(err, errorForbidden))
+	assert.Equal(t, 1, calls)
+
+	err = client.Do(context.Background(), http.MethodGet, ts.URL, "/forbidden", "token", nil, nil)
+	assert.True(t, errors.Is(err, errorForbidden))
+
+	// Invalid URL
+	err = client.Do(context.Background(), http.MethodGet, "://invalid", "/path", "token", nil, nil)
+	assert.Error(t, err)
+}
+
+func TestServiceClientBaseURL(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/devices/deviceId/settings/address/countryAndPostalCode", r.URL.Path)
+		w.Write([]byte(`{"countryCode":"DE","postalCode":"10115"}`))
+	}))
+	defer ts.Close()
+
+	client := NewServiceClient(nil)
+	client.BaseURL = ts.URL
+	system := &System{
+		APIAccessToken: "token",
+		APIEndpoint:    "https://api.amazonalexa.com",
+		Device: Device{
+			DeviceID: "deviceId",
+		},
+	}
+	address, err := NewDeviceAddressService(client).GetCountryAndPostalCode(system)
+	require.NoError(t, err)
+	assert.Equal(t, "DE", address.CountryCode)
+	assert.Equal(t, "10115", address.PostalCode)
+}
+
+func TestServiceClientCanceled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Unavailable", http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	client := NewServiceClient(nil)
+	client.RetryBackoff = time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := client.Do(ctx, http.MethodGet, ts.URL, "/", "token", nil, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestServiceClientRetryAfter(t *testing.T) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Retry-After", "3600")
+		http.Error(w, "Too many requests", http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+
+	client := NewServiceClient(ts.Client())
+	client.RetryBackoff = time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	// The client waits for the Retry-After time instead of the short backoff
+	err := client.Do(ctx, http.MethodGet, ts.URL, "/v1/test", "token", nil, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, calls)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	assert.Equal(t, 2*time.Second, parseRetryAfter("2"))
+	assert.Equal(t, time.Duration(0), parseRetryAfter(""))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("invalid"))
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	assert.InDelta(t, float64(time.Minute), float64(parseRetryAfter(date)), float64(2*time.Second))
+}
@@ -0,0 +1,52 @@
+package alexa
+
+// ServiceFactory provides the clients for the Alexa APIs. Handlers obtain it with CommonRequest.Services(),
+// so the clients can be replaced in tests, e.g. with a FakeServiceFactory.
+type ServiceFactory interface {
+	DeviceAddressService() DeviceAddressService
+	DirectiveService() DirectiveService
+	RemindersService() RemindersService
+	ListsService() ListsService
+	CustomerProfileService() CustomerProfileService
+	SettingsService() SettingsService
+	MonetizationService() MonetizationService
+}
+
+type serviceFactory struct {
+	client *ServiceClient
+}
+
+var defaultServiceFactory = NewServiceFactory(defaultServiceClient)
+
+// NewServiceFactory creates a service factory whose services send the requests with the given client.
+func NewServiceFactory(client *ServiceClient) ServiceFactory {
+	return &serviceFactory{client: client}
+}
+
+func (f *serviceFactory) DeviceAddressService() DeviceAddressService {
+	return NewDeviceAddressService(f.client)
+}
+
+func (f *serviceFactory) DirectiveService() DirectiveService {
+	return NewDirectiveService(f.client)
+}
+
+func (f *serviceFactory) RemindersService() RemindersService {
+	return NewRemindersService(f.client)
+}
+
+func (f *serviceFactory) ListsService() ListsService {
+	return NewListsService(f.client)
+}
+
+func (f *serviceFactory) CustomerProfileService() CustomerProfileService {
+	return NewCustomerProfileService(f.client)
+}
+
+func (f *serviceFactory) SettingsService() SettingsService {
+	return NewSettingsService(f.client)
+}
+
+func (f *serviceFactory) MonetizationService() MonetizationService {
+	return NewMonetizationService(f.client)
+}
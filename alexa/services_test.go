@@ -0,0 +1,210 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultServices(t *testing.T) {
+	var request CommonRequest
+	services := request.Services()
+	require.NotNil(t, services)
+	assert.IsType(t, &deviceAddressService{}, services.DeviceAddressService())
+	assert.IsType(t, &directiveService{}, services.DirectiveService())
+	assert.IsType(t, &remindersService{}, services.RemindersService())
+	assert.IsType(t, &listsService{}, services.ListsService())
+	assert.IsType(t, &customerProfileService{}, services.CustomerProfileService())
+	assert.IsType(t, &settingsService{}, services.SettingsService())
+	assert.IsType(t, &monetizationService{}, services.MonetizationService())
+}
+
+func TestFakeServices(t *testing.T) {
+	launchRequest, _ := ioutil.ReadFile("../resources/launch_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(launchRequest, &r))
+
+	addressService := &FakeDeviceAddressService{
+		ShortAddress: &DeviceShortAddress{CountryCode: "DE", PostalCode: "10115"},
+	}
+	skill := Skill{
+		Services: &FakeServiceFactory{
+			DeviceAddress: addressService,
+			Settings:      &FakeSettingsService{TimeZone: "Europe/Berlin"},
+		},
+		OnLaunch: func(request *LaunchRequest, response *ResponseEnvelope) {
+			addressService := request.Services().DeviceAddressService()
+			address, err := addressService.GetCountryAndPostalCodeWithContext(request.RequestContext(), &request.Context.System)
+			if addressService.IsNotAuthorizedError(err) {
+				response.Response.SetOutputSpeech("Please grant the address permission")
+				return
+			}
+			localTime, _ := request.Services().SettingsService().GetLocalTime(request.RequestContext(), &request.Context.System)
+			response.Response.SetOutputSpeech("Your postal code is " + address.PostalCode + " in " + localTime.Location().String())
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Equal(t, "<speak> Your postal code is 10115 in Europe/Berlin </speak>", response.Response.OutputSpeech.Ssml)
+	assert.Equal(t, 1, addressService.Calls)
+
+	addressService.NotAuthorized = true
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Equal(t, "<speak> Please grant the address permission </speak>", response.Response.OutputSpeech.Ssml)
+	assert.Equal(t, 2, addressService.Calls)
+
+	addressService.NotAuthorized = false
+	addressService.Err = errors.New("fake error")
+	fullAddress, err := addressService.GetFullAddress(&r.Context.System)
+	assert.EqualError(t, err, "fake error")
+	assert.Nil(t, fullAddress)
+	assert.False(t, addressService.IsNotAuthorizedError(err))
+}
+
+func TestFakeServicesNotConfigured(t *testing.T) {
+	services := &FakeServiceFactory{}
+	system := &System{}
+	ctx := context.Background()
+
+	_, err := services.DeviceAddressService().GetFullAddressWithContext(ctx, system)
+	assert.Equal(t, errServiceNotConfigured, err)
+	assert.Equal(t, errServiceNotConfigured, services.DirectiveService().SendProgressiveResponse(&CommonRequest{}, "Please wait"))
+	_, err = services.RemindersService().GetReminders(ctx, system)
+	assert.Equal(t, errServiceNotConfigured, err)
+	_, err = services.ListsService().GetListsMetadata(ctx, system)
+	assert.Equal(t, errServiceNotConfigured, err)
+	_, err = services.CustomerProfileService().GetName(ctx, system)
+	assert.Equal(t, errServiceNotConfigured, err)
+	_, err = services.SettingsService().GetLocalTime(ctx, system)
+	assert.Equal(t, errServiceNotConfigured, err)
+	_, err = services.MonetizationService().GetInSkillProducts(ctx, system, "en-US", nil)
+	assert.Equal(t, errServiceNotConfigured, err)
+}
+
+func TestFakeDirectiveService(t *testing.T) {
+	directiveService := &FakeDirectiveService{}
+	require.NoError(t, <-directiveService.StartProgressiveResponse(&CommonRequest{}, "Please wait"))
+	assert.Equal(t, []string{"Please wait"}, directiveService.Speeches)
+
+	directiveService.NotAuthorized = true
+	err := directiveService.SendProgressiveResponse(&CommonRequest{}, "Please wait")
+	assert.True(t, directiveService.IsNotAuthorizedError(err))
+	assert.Equal(t, 1, len(directiveService.Speeches))
+}
+
+func TestFakeRemindersService(t *testing.T) {
+	ctx := context.Background()
+	remindersService := &FakeRemindersService{}
+	created, err := remindersService.CreateReminder(ctx, nil, NewRelativeReminder(time.Hour).AddSpokenContent("en-US", "Tea", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "ON", created.Status)
+
+	updated, err := remindersService.UpdateReminder(ctx, nil, created.AlertToken, NewRelativeReminder(time.Minute).AddSpokenContent("en-US", "Tea", ""))
+	require.NoError(t, err)
+	assert.Equal(t, "2", updated.Version)
+
+	list, err := remindersService.GetReminders(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Alerts))
+	assert.Equal(t, 60, list.Alerts[0].Trigger.OffsetInSeconds)
+
+	require.NoError(t, remindersService.DeleteReminder(ctx, nil, created.AlertToken))
+	_, err = remindersService.GetReminder(ctx, nil, created.AlertToken)
+	assert.Error(t, err)
+
+	remindersService.NotAuthorized = true
+	_, err = remindersService.GetReminders(ctx, nil)
+	assert.True(t, remindersService.IsNotAuthorizedError(err))
+	assert.Equal(t, 6, remindersService.Calls)
+}
+
+func TestFakeListsService(t *testing.T) {
+	ctx := context.Background()
+	listsService := &FakeListsService{}
+	list, err := listsService.CreateList(ctx, nil, "Groceries")
+	require.NoError(t, err)
+	item, err := listsService.CreateListItem(ctx, nil, list.ListID, "Milk", ListItemStatusActive)
+	require.NoError(t, err)
+	_, err = listsService.CreateListItem(ctx, nil, list.ListID, "Bread", ListItemStatusActive)
+	require.NoError(t, err)
+
+	item.Status = ListItemStatusCompleted
+	updated, err := listsService.UpdateListItem(ctx, nil, list.ListID, item)
+	require.NoError(t, err)
+	assert.Equal(t, 2, updated.Version)
+
+	active, err := listsService.GetList(ctx, nil, list.ListID, ListItemStatusActive, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(active.Items))
+	assert.Equal(t, "Bread", active.Items[0].Value)
+
+	require.NoError(t, listsService.DeleteListItem(ctx, nil, list.ListID, item.ID))
+	_, err = listsService.GetListItem(ctx, nil, list.ListID, item.ID)
+	assert.Error(t, err)
+
+	metadata, err := listsService.GetListsMetadata(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(metadata.Lists))
+	assert.Equal(t, "Groceries", metadata.Lists[0].Name)
+}
+
+func TestFakeCustomerProfileService(t *testing.T) {
+	ctx := context.Background()
+	profileService := &FakeCustomerProfileService{GivenName: "Jane"}
+	givenName, err := profileService.GetGivenName(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Jane", givenName)
+
+	mobileNumber, err := profileService.GetMobileNumber(ctx, nil)
+	assert.True(t, profileService.IsNotFoundError(err))
+	assert.Nil(t, mobileNumber)
+
+	profileService.NotAuthorized = true
+	_, err = profileService.GetGivenName(ctx, nil)
+	assert.True(t, profileService.IsNotAuthorizedError(err))
+}
+
+func TestFakeSettingsService(t *testing.T) {
+	ctx := context.Background()
+	settingsService := &FakeSettingsService{TimeZone: "Europe/Berlin", DistanceUnits: "METRIC"}
+	distanceUnits, err := settingsService.GetDistanceUnits(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "METRIC", distanceUnits)
+	localTime, err := settingsService.GetLocalTime(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", localTime.Location().String())
+
+	settingsService.NotAuthorized = true
+	timeZone, err := settingsService.GetTimeZone(ctx, nil)
+	assert.True(t, settingsService.IsNotAuthorizedError(err))
+	assert.Equal(t, "", timeZone)
+	assert.Equal(t, 3, settingsService.Calls)
+}
+
+func TestFakeMonetizationService(t *testing.T) {
+	ctx := context.Background()
+	monetizationService := &FakeMonetizationService{
+		Products: []InSkillProduct{
+			{ProductID: "product-1", Type: InSkillProductTypeEntitlement, Entitled: InSkillProductEntitled, Purchasable: InSkillProductNotPurchasable},
+			{ProductID: "product-2", Type: InSkillProductTypeSubscription, Entitled: InSkillProductNotEntitled, Purchasable: InSkillProductPurchasable},
+		},
+	}
+	purchasable, err := monetizationService.GetInSkillProducts(ctx, nil, "en-US", &InSkillProductsFilter{Purchasable: InSkillProductPurchasable})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(purchasable.InSkillProducts))
+	assert.Equal(t, "product-2", purchasable.InSkillProducts[0].ProductID)
+
+	product, err := monetizationService.GetInSkillProduct(ctx, nil, "en-US", "product-1")
+	require.NoError(t, err)
+	assert.True(t, product.IsEntitled())
+
+	_, err = monetizationService.GetInSkillProduct(ctx, nil, "en-US", "unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,80 @@
+package alexa
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SettingsService provides methods to read the settings of the device like the time zone and the measurement units.
+// No permission is required.
+type SettingsService interface {
+	// GetTimeZone gets the time zone of the device, e.g. 'America/Los_Angeles'.
+	GetTimeZone(ctx context.Context, system *System) (string, error)
+	// GetDistanceUnits gets the distance measurement unit of the device, METRIC or IMPERIAL.
+	GetDistanceUnits(ctx context.Context, system *System) (string, error)
+	// GetTemperatureUnit gets the temperature measurement unit of the device, CELSIUS or FAHRENHEIT.
+	GetTemperatureUnit(ctx context.Context, system *System) (string, error)
+	// GetLocalTime returns the current time in the time zone of the device. It needs the time zone database of the host,
+	// applications running on systems without zoneinfo, e.g. the lambda provided runtimes, should import time/tzdata or build with -tags timetzdata.
+	GetLocalTime(ctx context.Context, system *System) (time.Time, error)
+	// IsNotAuthorizedError return true if the access token of the request is invalid
+	IsNotAuthorizedError(err error) bool
+}
+
+// Measurement units of the device settings
+const (
+	DistanceUnitsMetric       = "METRIC"
+	DistanceUnitsImperial     = "IMPERIAL"
+	TemperatureUnitCelsius    = "CELSIUS"
+	TemperatureUnitFahrenheit = "FAHRENHEIT"
+)
+
+type settingsService struct {
+	client *ServiceClient
+}
+
+var settingsServiceInstance = NewSettingsService(defaultServiceClient)
+
+// NewSettingsService creates a settings service sending the requests with the given client.
+func NewSettingsService(client *ServiceClient) SettingsService {
+	return &settingsService{client: client}
+}
+
+func (s *settingsService) getSetting(ctx context.Context, system *System, name string) (string, error) {
+	path := fmt.Sprintf("/v2/devices/%s/settings/%s", url.PathEscape(system.Device.DeviceID), name)
+	var value string
+	err := s.client.Do(ctx, http.MethodGet, system.APIEndpoint, path, system.APIAccessToken, nil, &value)
+	return value, err
+}
+
+func (s *settingsService) GetTimeZone(ctx context.Context, system *System) (string, error) {
+	return s.getSetting(ctx, system, "System.timeZone")
+}
+
+func (s *settingsService) GetDistanceUnits(ctx context.Context, system *System) (string, error) {
+	return s.getSetting(ctx, system, "System.distanceUnits")
+}
+
+func (s *settingsService) GetTemperatureUnit(ctx context.Context, system *System) (string, error) {
+	return s.getSetting(ctx, system, "System.temperatureUnit")
+}
+
+func (s *settingsService) GetLocalTime(ctx context.Context, system *System) (time.Time, error) {
+	timeZone, err := s.GetTimeZone(ctx, system)
+	if err != nil {
+		return time.Time{}, err
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Now().In(location), nil
+}
+
+func (s *settingsService) IsNotAuthorizedError(err error) bool {
+	return errors.Is(err, errorForbidden)
+}
@@ -0,0 +1,60 @@
+package alexa
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettingsService(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer tokenOk" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v2/devices/deviceId/settings/System.timeZone":
+			w.Write([]byte(`"Europe/Berlin"`))
+		case "/v2/devices/deviceId/settings/System.distanceUnits":
+			w.Write([]byte(`"METRIC"`))
+		case "/v2/devices/deviceId/settings/System.temperatureUnit":
+			w.Write([]byte(`"CELSIUS"`))
+		default:
+			http.Error(w, "Not found", http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	settingsService := GetSettingsService()
+	system := &System{
+		APIAccessToken: "tokenOk",
+		APIEndpoint:    ts.URL,
+	}
+	system.Device.DeviceID = "deviceId"
+
+	timeZone, err := settingsService.GetTimeZone(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", timeZone)
+
+	distanceUnits, err := settingsService.GetDistanceUnits(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, DistanceUnitsMetric, distanceUnits)
+
+	temperatureUnit, err := settingsService.GetTemperatureUnit(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, TemperatureUnitCelsius, temperatureUnit)
+
+	localTime, err := settingsService.GetLocalTime(context.Background(), system)
+	require.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", localTime.Location().String())
+
+	// Invalid access token
+	system.APIAccessToken = "tokenNotOk"
+	_, err = settingsService.GetLocalTime(context.Background(), system)
+	assert.Error(t, err)
+	assert.True(t, settingsService.IsNotAuthorizedError(err))
+}
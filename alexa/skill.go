@@ -10,6 +10,8 @@ type Skill struct {
 	ApplicationID string
 	// SkipValidation skips any request validation (TEST ONLY!)
 	SkipValidation bool
+	// StripUnsupportedDirectives removes directives the requesting device can not render (e.g. APL directives for devices without screen)
+	StripUnsupportedDirectives bool
 	// Verbose enables request and response logging
 	Verbose                  bool
 	OnLaunch                 func(*LaunchRequest, *ResponseEnvelope)
@@ -19,6 +21,32 @@ type Skill struct {
 	OnAudioPlayerFailedState func(*AudioPlayerPlaybackFailedRequest, *ResponseEnvelope)
 	OnSystemException        func(*SystemExceptionEncounteredRequest, *ResponseEnvelope)
 	OnGameEngineEvent        func(*GameEngineInputHandlerEventRequest, *ResponseEnvelope)
+	OnAPLUserEvent           func(*APLUserEventRequest, *ResponseEnvelope)
+	// OnDialogAPIInvoked handles Alexa Conversations API requests without a handler in DialogAPIHandlers
+	OnDialogAPIInvoked func(*DialogAPIInvokedRequest, *ResponseEnvelope)
+	DialogAPIHandlers  DialogAPIHandlers
+	// OnCanFulfillIntent answers CanFulfillIntentRequests. If it is nil the answer is derived from CanFulfillIntents, without both the answer is NO
+	OnCanFulfillIntent func(*CanFulfillIntentRequest, *ResponseEnvelope)
+	// CanFulfillIntents maps the names of the intents the skill can fulfill to the names of the slots it can fulfill
+	CanFulfillIntents map[string][]string
+	// OnReminderEvent handles Reminders.* events. The response is ignored
+	OnReminderEvent func(*ReminderEventRequest, *ResponseEnvelope)
+	// OnSkillEvent handles AlexaSkillEvent.* events. The response is ignored
+	OnSkillEvent func(*SkillEventRequest, *ResponseEnvelope)
+	// OnListEvent handles AlexaHouseholdListEvent.* events. The response is ignored
+	OnListEvent func(*ListEventRequest, *ResponseEnvelope)
+	// OnMessageReceived handles Messaging.MessageReceived requests sent with the skill messaging API. The response is ignored
+	OnMessageReceived func(*MessageReceivedRequest, *ResponseEnvelope)
+	// OnConnectionsResponse handles the results of Connections.SendRequest directives, e.g. in-skill purchases
+	OnConnectionsResponse func(*ConnectionsResponseRequest, *ResponseEnvelope)
+	// OnAskForPermission handles the answer to a AddAskForPermissionDirective. Other Connections.Response requests are handled by OnConnectionsResponse
+	OnAskForPermission func(*ConnectionsResponseRequest, *AskForPermissionResult, *ResponseEnvelope)
+	// TaskHandlers perform the tasks the skill is launched with. LaunchRequests without task are handled by OnLaunch, tasks without handler are completed with TaskStatusNotFound
+	TaskHandlers TaskHandlers
+	// OnSessionResumed handles the results of tasks started with Connections.StartConnection directives
+	OnSessionResumed func(*SessionResumedRequest, *ResponseEnvelope)
+	// Services provides the Alexa API clients to the handlers with CommonRequest.Services(). The default clients are used if it is nil
+	Services ServiceFactory
 }
 
 // GetDeviceAddressService provides an instance of the device address service to query a customers address information.
@@ -26,7 +54,38 @@ func GetDeviceAddressService() DeviceAddressService {
 	return deviceAddressServiceInstance
 }
 
+// GetDirectiveService provides an instance of the directive service to send progressive responses.
+func GetDirectiveService() DirectiveService {
+	return directiveServiceInstance
+}
+
+// GetRemindersService provides an instance of the reminders service to manage a customers reminders.
+func GetRemindersService() RemindersService {
+	return remindersServiceInstance
+}
+
+// GetListsService provides an instance of the lists service to read and write a customers household lists.
+func GetListsService() ListsService {
+	return listsServiceInstance
+}
+
+// GetCustomerProfileService provides an instance of the customer profile service to query the contact information of a customer.
+func GetCustomerProfileService() CustomerProfileService {
+	return customerProfileServiceInstance
+}
+
+// GetSettingsService provides an instance of the settings service to query the time zone and measurement units of a device.
+func GetSettingsService() SettingsService {
+	return settingsServiceInstance
+}
+
+// GetMonetizationService provides an instance of the monetization service to query the in-skill products of the skill.
+func GetMonetizationService() MonetizationService {
+	return monetizationServiceInstance
+}
+
 func (requestEnvelope *RequestEnvelope) handleRequest(skill *Skill) (*ResponseEnvelope, error) {
+	requestEnvelope.services = skill.Services
 	//Read the type for this request to do the correct routing
 	var commonRequest CommonRequest
 	err := requestEnvelope.getTypedRequest(&commonRequest)
@@ -41,14 +100,11 @@ func (requestEnvelope *RequestEnvelope) handleRequest(skill *Skill) (*ResponseEn
 
 	// Request handling
 	if requestType == "LaunchRequest" {
-
-		if skill.OnLaunch != nil {
-			//Map to the correct type
-			var request LaunchRequest
-			// Create concrete types
-			requestEnvelope.getTypedRequest(&request)
-			skill.OnLaunch(&request, response)
-		}
+		//Map to the correct type
+		var request LaunchRequest
+		// Create concrete types
+		requestEnvelope.getTypedRequest(&request)
+		skill.handleLaunchRequest(&request, response)
 	} else if requestType == "IntentRequest" {
 		if skill.OnIntent != nil {
 			var request IntentRequest
@@ -56,6 +112,18 @@ func (requestEnvelope *RequestEnvelope) handleRequest(skill *Skill) (*ResponseEn
 			requestEnvelope.getTypedRequest(&request)
 			skill.OnIntent(&request, response)
 		}
+	} else if requestType == "CanFulfillIntentRequest" {
+		var request CanFulfillIntentRequest
+		// Create concrete types
+		requestEnvelope.getTypedRequest(&request)
+		if skill.OnCanFulfillIntent != nil {
+			skill.OnCanFulfillIntent(&request, response)
+		} else if skill.CanFulfillIntents != nil {
+			response.Response.CanFulfillIntent = DeriveCanFulfillIntent(&request, skill.CanFulfillIntents)
+		} else {
+			// A skill without handler can not fulfill any intent
+			response.Response.SetCanFulfillIntent(CanFulfillNo)
+		}
 	} else if requestType == "SessionEndedRequest" {
 		if skill.OnSessionEnded != nil {
 			var request SessionEndedRequest
@@ -83,6 +151,70 @@ func (requestEnvelope *RequestEnvelope) handleRequest(skill *Skill) (*ResponseEn
 			requestEnvelope.getTypedRequest(&request)
 			skill.OnGameEngineEvent(&request, response)
 		}
+	} else if requestType == "Alexa.Presentation.APL.UserEvent" {
+		if skill.OnAPLUserEvent != nil {
+			var request APLUserEventRequest
+			// Create concrete types
+			requestEnvelope.getTypedRequest(&request)
+			skill.OnAPLUserEvent(&request, response)
+		}
+	} else if requestType == "Dialog.API.Invoked" {
+		var request DialogAPIInvokedRequest
+		// Create concrete types
+		requestEnvelope.getTypedRequest(&request)
+		skill.handleDialogAPIInvoked(&request, response)
+	} else if strings.HasPrefix(requestType, "Reminders.") {
+		if skill.OnReminderEvent != nil {
+			var request ReminderEventRequest
+			// Create concrete types
+			requestEnvelope.getTypedRequest(&request)
+			skill.OnReminderEvent(&request, response)
+		}
+		// Events expect a empty response
+		response.Response = nil
+	} else if strings.HasPrefix(requestType, "AlexaSkillEvent.") {
+		if skill.OnSkillEvent != nil {
+			var request SkillEventRequest
+			// Create concrete types
+			requestEnvelope.getTypedRequest(&request)
+			skill.OnSkillEvent(&request, response)
+		}
+		// Events expect a empty response
+		response.Response = nil
+	} else if strings.HasPrefix(requestType, "AlexaHouseholdListEvent.") {
+		if skill.OnListEvent != nil {
+			var request ListEventRequest
+			// Create concrete types
+			requestEnvelope.getTypedRequest(&request)
+			skill.OnListEvent(&request, response)
+		}
+		// Events expect a empty response
+		response.Response = nil
+	} else if requestType == "Messaging.MessageReceived" {
+		if skill.OnMessageReceived != nil {
+			var request MessageReceivedRequest
+			// Create concrete types
+			requestEnvelope.getTypedRequest(&request)
+			skill.OnMessageReceived(&request, response)
+		}
+		// Messages expect a empty response
+		response.Response = nil
+	} else if requestType == "Connections.Response" {
+		var request ConnectionsResponseRequest
+		// Create concrete types
+		requestEnvelope.getTypedRequest(&request)
+		if request.Name == "AskFor" && skill.OnAskForPermission != nil {
+			skill.handleAskForPermission(&request, response)
+		} else if skill.OnConnectionsResponse != nil {
+			skill.OnConnectionsResponse(&request, response)
+		}
+	} else if requestType == "SessionResumedRequest" {
+		if skill.OnSessionResumed != nil {
+			var request SessionResumedRequest
+			// Create concrete types
+			requestEnvelope.getTypedRequest(&request)
+			skill.OnSessionResumed(&request, response)
+		}
 	} else if requestType == "System.ExceptionEncountered" {
 		if skill.OnSystemException != nil {
 			var request SystemExceptionEncounteredRequest
@@ -93,5 +225,8 @@ func (requestEnvelope *RequestEnvelope) handleRequest(skill *Skill) (*ResponseEn
 	} else {
 		return nil, errors.New("Invalid request type: " + requestType)
 	}
+	if skill.StripUnsupportedDirectives {
+		response.Response.stripUnsupportedDirectives(&requestEnvelope.Context.System.Device)
+	}
 	return response, nil
 }
@@ -0,0 +1,25 @@
+package alexa
+
+// SkillEventRequest is send if the customer enables or disables the skill, accepts or changes permissions or links the account.
+// Types are AlexaSkillEvent.SkillEnabled, SkillDisabled, SkillPermissionAccepted, SkillPermissionChanged and SkillAccountLinked.
+// The request has no session and the response is ignored.
+type SkillEventRequest struct {
+	CommonRequest
+	EventCreationTime   string `json:"eventCreationTime"`
+	EventPublishingTime string `json:"eventPublishingTime"`
+	Body                struct {
+		// AcceptedPermissions is set for SkillPermissionAccepted and SkillPermissionChanged events
+		AcceptedPermissions []SkillEventPermission `json:"acceptedPermissions,omitempty"`
+		// AcceptedPersonPermissions is set for SkillPermissionAccepted and SkillPermissionChanged events for recognized speakers
+		AcceptedPersonPermissions []SkillEventPermission `json:"acceptedPersonPermissions,omitempty"`
+		// AccessToken is set for SkillAccountLinked events
+		AccessToken string `json:"accessToken,omitempty"`
+		// UserInformationPersistenceStatus is set for SkillDisabled events. PERSISTED if the customer data is kept after disabling the skill, otherwise NOT_PERSISTED.
+		UserInformationPersistenceStatus string `json:"userInformationPersistenceStatus,omitempty"`
+	} `json:"body"`
+}
+
+// SkillEventPermission is a permission scope accepted by the customer, e.g. 'read::alexa:device:all:address'.
+type SkillEventPermission struct {
+	Scope string `json:"scope"`
+}
@@ -0,0 +1,38 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkillEvent(t *testing.T) {
+	eventRequest, _ := ioutil.ReadFile("../resources/skill_event_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(eventRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnSkillEvent: func(request *SkillEventRequest, response *ResponseEnvelope) {
+			called = true
+			assert.Equal(t, "AlexaSkillEvent.SkillPermissionAccepted", request.Type)
+			assert.Equal(t, "2015-05-13T12:34:50Z", request.EventCreationTime)
+			assert.Equal(t, []SkillEventPermission{{Scope: "alexa::alerts:reminders:skill:readwrite"}}, request.Body.AcceptedPermissions)
+			assert.Equal(t, "alexa::profile:given_name:read", request.Body.AcceptedPersonPermissions[0].Scope)
+			assert.Equal(t, "amzn1.account.AM3B00000000000000000000000", request.Context.System.User.UserID)
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Nil(t, response.Response)
+
+	// Events without handler are accepted
+	skill.OnSkillEvent = nil
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.Nil(t, response.Response)
+}
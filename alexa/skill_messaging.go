@@ -0,0 +1,85 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SkillMessagingService sends messages to the skill on behalf of a customer, e.g. if a external event happened.
+// The skill receives them as Messaging.MessageReceived request and can call the Alexa APIs like the reminders API without a session.
+// The messages are authorized with a LWA token for the scope 'alexa:skill_messaging'.
+type SkillMessagingService interface {
+	// SendMessage sends the data as message to the skill for the customer with the given user id.
+	// The message is discarded if it can not be delivered within expiresAfter. If expiresAfter is 0 one hour is used.
+	SendMessage(ctx context.Context, userID string, data interface{}, expiresAfter time.Duration) error
+}
+
+// Expiry limits of skill messages
+const (
+	SkillMessageMinExpiry = time.Minute
+	SkillMessageMaxExpiry = 7 * 24 * time.Hour
+)
+
+var errInvalidSkillMessageExpiry = errors.New("The expiry of a skill message must be between 1 minute and 7 days")
+
+var errMissingMessage = errors.New("Messaging request has no message")
+
+// SkillMessage is sent to the skill messaging API.
+type SkillMessage struct {
+	Data                interface{} `json:"data"`
+	ExpiresAfterSeconds int         `json:"expiresAfterSeconds"`
+}
+
+type skillMessagingService struct {
+	client      *ServiceClient
+	lwa         *LWAClient
+	apiEndpoint string
+}
+
+// NewSkillMessagingService creates a skill messaging service for the API endpoint of the region.
+// The access tokens are requested with the lwa client and the messages are sent with the given client.
+func NewSkillMessagingService(client *ServiceClient, lwa *LWAClient, apiEndpoint string) SkillMessagingService {
+	return &skillMessagingService{
+		client:      client,
+		lwa:         lwa,
+		apiEndpoint: apiEndpoint,
+	}
+}
+
+func (s *skillMessagingService) SendMessage(ctx context.Context, userID string, data interface{}, expiresAfter time.Duration) error {
+	if expiresAfter == 0 {
+		expiresAfter = time.Hour
+	}
+	if expiresAfter < SkillMessageMinExpiry || expiresAfter > SkillMessageMaxExpiry {
+		return errInvalidSkillMessageExpiry
+	}
+	accessToken, err := s.lwa.GetAccessToken(ctx, LWAScopeSkillMessaging)
+	if err != nil {
+		return err
+	}
+	message := &SkillMessage{
+		Data:                data,
+		ExpiresAfterSeconds: int(expiresAfter.Seconds()),
+	}
+	return s.client.Do(ctx, http.MethodPost, s.apiEndpoint, "/v1/skillmessages/users/"+url.PathEscape(userID), accessToken, message, nil)
+}
+
+// MessageReceivedRequest is send if a message was sent to the skill with the skill messaging API.
+// The request has no session and the response is ignored.
+type MessageReceivedRequest struct {
+	CommonRequest
+	// Message contains the data of the skill message. Use BindMessage to map it to a struct.
+	Message json.RawMessage `json:"message,omitempty"`
+}
+
+// BindMessage maps the message data to the given struct using the json tags of the struct.
+func (r *MessageReceivedRequest) BindMessage(target interface{}) error {
+	if len(r.Message) == 0 {
+		return errMissingMessage
+	}
+	return json.Unmarshal(r.Message, target)
+}
@@ -0,0 +1,76 @@
+package alexa
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkillMessagingService(t *testing.T) {
+	lwa := newLWAScopeTestServer()
+	defer lwa.Close()
+	received := make(chan SkillMessage, 1)
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v1/skillmessages/users/amzn1.ask.account.1", r.URL.Path)
+		if r.Header.Get("Authorization") != "Bearer token-alexa:skill_messaging" {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		var message SkillMessage
+		json.NewDecoder(r.Body).Decode(&message)
+		received <- message
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer api.Close()
+
+	lwaClient := NewLWAClient("clientId", "secret")
+	lwaClient.TokenURL = lwa.URL
+	service := NewSkillMessagingService(NewServiceClient(nil), lwaClient, api.URL)
+
+	require.NoError(t, service.SendMessage(context.Background(), "amzn1.ask.account.1", map[string]string{"event": "ORDER_DELAYED"}, 0))
+	message := <-received
+	assert.Equal(t, map[string]interface{}{"event": "ORDER_DELAYED"}, message.Data)
+	assert.Equal(t, 3600, message.ExpiresAfterSeconds)
+
+	require.NoError(t, service.SendMessage(context.Background(), "amzn1.ask.account.1", "data", 10*time.Minute))
+	message = <-received
+	assert.Equal(t, 600, message.ExpiresAfterSeconds)
+
+	err := service.SendMessage(context.Background(), "amzn1.ask.account.1", "data", time.Second)
+	assert.Equal(t, errInvalidSkillMessageExpiry, err)
+}
+
+func TestMessageReceived(t *testing.T) {
+	messageRequest, _ := ioutil.ReadFile("../resources/message_received_request.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(messageRequest, &r))
+
+	called := false
+	skill := Skill{
+		OnMessageReceived: func(request *MessageReceivedRequest, response *ResponseEnvelope) {
+			called = true
+			var message struct {
+				Event   string `json:"event"`
+				OrderID string `json:"orderId"`
+			}
+			require.NoError(t, request.BindMessage(&message))
+			assert.Equal(t, "ORDER_DELAYED", message.Event)
+			assert.Equal(t, "order-1", message.OrderID)
+			assert.Equal(t, "AxThk...", request.Context.System.APIAccessToken)
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.True(t, called)
+	assert.Nil(t, response.Response)
+
+	var request MessageReceivedRequest
+	assert.Equal(t, errMissingMessage, request.BindMessage(&struct{}{}))
+}
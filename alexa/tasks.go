@@ -0,0 +1,82 @@
+package alexa
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// LaunchRequestTask is the task another skill or a Alexa routine asks the skill to perform.
+type LaunchRequestTask struct {
+	// Name of the task, e.g. 'amzn1.ask.skill.00000000-0000-0000-0000-000000000000.CountDown'
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// Input contains the task parameters. Use BindInput to map them to a struct.
+	Input json.RawMessage `json:"input,omitempty"`
+}
+
+var errMissingTaskInput = errors.New("Task has no input")
+
+// BindInput maps the input of the task to the given struct using the json tags of the struct.
+func (t *LaunchRequestTask) BindInput(target interface{}) error {
+	if len(t.Input) == 0 {
+		return errMissingTaskInput
+	}
+	return json.Unmarshal(t.Input, target)
+}
+
+// TaskHandlers maps the name of a task to the handler performing it.
+type TaskHandlers map[string]func(*LaunchRequest, *ResponseEnvelope)
+
+// Status codes of a completed task
+const (
+	TaskStatusOK            = "200"
+	TaskStatusBadRequest    = "400"
+	TaskStatusNotFound      = "404"
+	TaskStatusInternalError = "500"
+)
+
+// TasksCompleteTaskDirective returns the result of a task to the requester.
+type TasksCompleteTaskDirective struct {
+	Type   string            `json:"type"`
+	Status ConnectionsStatus `json:"status"`
+	Result *struct {
+		Payload interface{} `json:"payload"`
+	} `json:"result,omitempty"`
+}
+
+// AddTasksCompleteTaskDirective creates a new directive to complete the task with the given status and adds it to the response.
+func (r *Response) AddTasksCompleteTaskDirective(code, message string) *TasksCompleteTaskDirective {
+	d := &TasksCompleteTaskDirective{
+		Type: "Tasks.CompleteTask",
+		Status: ConnectionsStatus{
+			Code:    code,
+			Message: message,
+		},
+	}
+	r.AddDirective(d)
+	return d
+}
+
+// SetResult sets the payload returned to the requester of the task.
+func (d *TasksCompleteTaskDirective) SetResult(payload interface{}) *TasksCompleteTaskDirective {
+	d.Result = &struct {
+		Payload interface{} `json:"payload"`
+	}{Payload: payload}
+	return d
+}
+
+// handleLaunchRequest calls the handler of the task if the skill is launched with a task, otherwise the OnLaunch handler.
+// Tasks without handler are completed with the status TaskStatusNotFound.
+func (skill *Skill) handleLaunchRequest(request *LaunchRequest, response *ResponseEnvelope) {
+	if request.Task != nil {
+		if handler, ok := skill.TaskHandlers[request.Task.Name]; ok {
+			handler(request, response)
+		} else {
+			response.Response.AddTasksCompleteTaskDirective(TaskStatusNotFound, "Unknown task "+request.Task.Name)
+		}
+		return
+	}
+	if skill.OnLaunch != nil {
+		skill.OnLaunch(request, response)
+	}
+}
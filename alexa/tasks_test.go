@@ -0,0 +1,60 @@
+package alexa
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const countDownTask = "amzn1.ask.skill.00000000-0000-0000-0000-000000000000.CountDown"
+
+func TestTaskHandlers(t *testing.T) {
+	taskRequest, _ := ioutil.ReadFile("../resources/launch_request_task.json")
+	var r RequestEnvelope
+	require.NoError(t, json.Unmarshal(taskRequest, &r))
+
+	launched := false
+	skill := Skill{
+		OnLaunch: func(request *LaunchRequest, response *ResponseEnvelope) {
+			launched = true
+		},
+		TaskHandlers: TaskHandlers{
+			countDownTask: func(request *LaunchRequest, response *ResponseEnvelope) {
+				assert.Equal(t, "1", request.Task.Version)
+				var input struct {
+					UpLimit   int `json:"upLimit"`
+					DownLimit int `json:"downLimit"`
+				}
+				require.NoError(t, request.Task.BindInput(&input))
+				response.Response.AddTasksCompleteTaskDirective(TaskStatusOK, "Counted down").
+					SetResult(map[string]int{"counted": input.UpLimit - input.DownLimit})
+			},
+		},
+	}
+	response, err := r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.False(t, launched)
+	bytes, _ := json.Marshal(response.Response.Directives)
+	assert.JSONEq(t, `[{"type": "Tasks.CompleteTask", "status": {"code": "200", "message": "Counted down"}, "result": {"payload": {"counted": 10}}}]`, string(bytes))
+
+	// Unknown tasks are completed with not found
+	delete(skill.TaskHandlers, countDownTask)
+	response, err = r.handleRequest(&skill)
+	require.NoError(t, err)
+	assert.False(t, launched)
+	bytes, _ = json.Marshal(response.Response.Directives)
+	assert.JSONEq(t, `[{"type": "Tasks.CompleteTask", "status": {"code": "404", "message": "Unknown task `+countDownTask+`"}}]`, string(bytes))
+}
+
+func TestCompleteTaskWithoutResult(t *testing.T) {
+	var response Response
+	response.AddTasksCompleteTaskDirective(TaskStatusNotFound, "Unknown task")
+	bytes, _ := json.Marshal(response)
+	assert.JSONEq(t, `{"directives": [{"type": "Tasks.CompleteTask", "status": {"code": "404", "message": "Unknown task"}}]}`, string(bytes))
+
+	var task LaunchRequestTask
+	assert.Equal(t, errMissingTaskInput, task.BindInput(&struct{}{}))
+}